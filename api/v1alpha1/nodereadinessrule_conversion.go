@@ -0,0 +1,412 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"sigs.k8s.io/node-readiness-controller/api/v1beta1"
+)
+
+// v1alpha1 leaves conditionPolicy and defaultStatus optional, with "allOf" and
+// "Unknown" applied at read time, while v1beta1 requires them. Converting to
+// v1beta1 makes those defaults explicit, and converting back omits them again,
+// so that objects written through either version are stored the same way.
+
+var _ conversion.Convertible = &NodeReadinessRule{}
+
+// ConvertTo converts this NodeReadinessRule to the hub version (v1beta1).
+func (src *NodeReadinessRule) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.NodeReadinessRule)
+	dst.ObjectMeta = src.ObjectMeta
+	convertSpecToV1beta1(&src.Spec, &dst.Spec)
+	convertStatusToV1beta1(&src.Status, &dst.Status)
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this version.
+func (dst *NodeReadinessRule) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.NodeReadinessRule)
+	dst.ObjectMeta = src.ObjectMeta
+	convertSpecFromV1beta1(&src.Spec, &dst.Spec)
+	convertStatusFromV1beta1(&src.Status, &dst.Status)
+	return nil
+}
+
+// convertSlice converts each element of in, keeping nil and empty slices apart.
+func convertSlice[In, Out any](in []In, convert func(*In, *Out)) []Out {
+	if in == nil {
+		return nil
+	}
+	out := make([]Out, len(in))
+	for i := range in {
+		convert(&in[i], &out[i])
+	}
+	return out
+}
+
+func convertEnforcementModeToV1beta1(in EnforcementMode) v1beta1.EnforcementMode {
+	switch in {
+	case EnforcementModeBootstrapOnly:
+		return v1beta1.EnforcementModeBootstrapOnly
+	case EnforcementModeContinuous:
+		return v1beta1.EnforcementModeContinuous
+	default:
+		return v1beta1.EnforcementMode(in)
+	}
+}
+
+func convertEnforcementModeFromV1beta1(in v1beta1.EnforcementMode) EnforcementMode {
+	switch in {
+	case v1beta1.EnforcementModeBootstrapOnly:
+		return EnforcementModeBootstrapOnly
+	case v1beta1.EnforcementModeContinuous:
+		return EnforcementModeContinuous
+	default:
+		return EnforcementMode(in)
+	}
+}
+
+func convertConditionPolicyToV1beta1(in ConditionPolicy) v1beta1.ConditionPolicy {
+	switch in {
+	case "", ConditionPolicyAllOf:
+		return v1beta1.ConditionPolicyAllOf
+	case ConditionPolicyAnyOf:
+		return v1beta1.ConditionPolicyAnyOf
+	default:
+		return v1beta1.ConditionPolicy(in)
+	}
+}
+
+func convertConditionPolicyFromV1beta1(in v1beta1.ConditionPolicy) ConditionPolicy {
+	switch in {
+	case v1beta1.ConditionPolicyAllOf:
+		return ""
+	case v1beta1.ConditionPolicyAnyOf:
+		return ConditionPolicyAnyOf
+	default:
+		return ConditionPolicy(in)
+	}
+}
+
+func convertSpecToV1beta1(in *NodeReadinessRuleSpec, out *v1beta1.NodeReadinessRuleSpec) {
+	out.Conditions = convertSlice(in.Conditions, convertConditionRequirementToV1beta1)
+	out.EnforcementMode = convertEnforcementModeToV1beta1(in.EnforcementMode)
+	out.Taint = in.Taint
+	out.NodeSelector = in.NodeSelector
+	out.ConditionPolicy = convertConditionPolicyToV1beta1(in.ConditionPolicy)
+	out.DryRun = in.DryRun
+	out.NodeScope = v1beta1.NodeScope{
+		Type:          v1beta1.NodeScopeType(in.NodeScope.Type),
+		MaxAgeSeconds: in.NodeScope.MaxAgeSeconds,
+	}
+	out.BootstrapRearmTriggers = convertSlice(in.BootstrapRearmTriggers, func(in *BootstrapRearmTrigger, out *v1beta1.BootstrapRearmTrigger) {
+		*out = v1beta1.BootstrapRearmTrigger(*in)
+	})
+	out.BootstrapID = in.BootstrapID
+	out.BootstrapAdoptionPolicy = v1beta1.BootstrapAdoptionPolicy(in.BootstrapAdoptionPolicy)
+	out.FlapDetection = v1beta1.FlapDetection{
+		TransitionThreshold: in.FlapDetection.TransitionThreshold,
+		WindowSeconds:       in.FlapDetection.WindowSeconds,
+		CooldownSeconds:     in.FlapDetection.CooldownSeconds,
+	}
+	out.TaintEscalation = convertSlice(in.TaintEscalation, func(in *TaintEscalationStep, out *v1beta1.TaintEscalationStep) {
+		*out = v1beta1.TaintEscalationStep{Effect: in.Effect, AfterSeconds: in.AfterSeconds}
+	})
+	out.TaintAdoptionPolicy = v1beta1.TaintAdoptionPolicy(in.TaintAdoptionPolicy)
+	out.NodeExitPolicy = v1beta1.NodeExitPolicy(in.NodeExitPolicy)
+	out.DeletionPolicy = v1beta1.DeletionPolicy(in.DeletionPolicy)
+	out.SuccessorRuleName = in.SuccessorRuleName
+	out.Rollout = v1beta1.Rollout{
+		Stages: convertSlice(in.Rollout.Stages, func(in *RolloutStage, out *v1beta1.RolloutStage) {
+			*out = v1beta1.RolloutStage{Percent: in.Percent, PauseSeconds: in.PauseSeconds}
+		}),
+		MaxFailurePercent:    in.Rollout.MaxFailurePercent,
+		MaxNewlyTaintedNodes: in.Rollout.MaxNewlyTaintedNodes,
+	}
+	out.Schedule = v1beta1.RuleSchedule{
+		ActivationWindows: convertSlice(in.Schedule.ActivationWindows, func(in *ActivationWindow, out *v1beta1.ActivationWindow) {
+			*out = v1beta1.ActivationWindow{Start: in.Start, DurationSeconds: in.DurationSeconds}
+		}),
+		TimeZone:  in.Schedule.TimeZone,
+		ExpiresAt: in.Schedule.ExpiresAt,
+	}
+	out.Drain = v1beta1.Drain{
+		AfterSeconds:          in.Drain.AfterSeconds,
+		MaxEvictionsPerMinute: in.Drain.MaxEvictionsPerMinute,
+	}
+}
+
+func convertSpecFromV1beta1(in *v1beta1.NodeReadinessRuleSpec, out *NodeReadinessRuleSpec) {
+	out.Conditions = convertSlice(in.Conditions, convertConditionRequirementFromV1beta1)
+	out.EnforcementMode = convertEnforcementModeFromV1beta1(in.EnforcementMode)
+	out.Taint = in.Taint
+	out.NodeSelector = in.NodeSelector
+	out.ConditionPolicy = convertConditionPolicyFromV1beta1(in.ConditionPolicy)
+	out.DryRun = in.DryRun
+	out.NodeScope = NodeScope{
+		Type:          NodeScopeType(in.NodeScope.Type),
+		MaxAgeSeconds: in.NodeScope.MaxAgeSeconds,
+	}
+	out.BootstrapRearmTriggers = convertSlice(in.BootstrapRearmTriggers, func(in *v1beta1.BootstrapRearmTrigger, out *BootstrapRearmTrigger) {
+		*out = BootstrapRearmTrigger(*in)
+	})
+	out.BootstrapID = in.BootstrapID
+	out.BootstrapAdoptionPolicy = BootstrapAdoptionPolicy(in.BootstrapAdoptionPolicy)
+	out.FlapDetection = FlapDetection{
+		TransitionThreshold: in.FlapDetection.TransitionThreshold,
+		WindowSeconds:       in.FlapDetection.WindowSeconds,
+		CooldownSeconds:     in.FlapDetection.CooldownSeconds,
+	}
+	out.TaintEscalation = convertSlice(in.TaintEscalation, func(in *v1beta1.TaintEscalationStep, out *TaintEscalationStep) {
+		*out = TaintEscalationStep{Effect: in.Effect, AfterSeconds: in.AfterSeconds}
+	})
+	out.TaintAdoptionPolicy = TaintAdoptionPolicy(in.TaintAdoptionPolicy)
+	out.NodeExitPolicy = NodeExitPolicy(in.NodeExitPolicy)
+	out.DeletionPolicy = DeletionPolicy(in.DeletionPolicy)
+	out.SuccessorRuleName = in.SuccessorRuleName
+	out.Rollout = Rollout{
+		Stages: convertSlice(in.Rollout.Stages, func(in *v1beta1.RolloutStage, out *RolloutStage) {
+			*out = RolloutStage{Percent: in.Percent, PauseSeconds: in.PauseSeconds}
+		}),
+		MaxFailurePercent:    in.Rollout.MaxFailurePercent,
+		MaxNewlyTaintedNodes: in.Rollout.MaxNewlyTaintedNodes,
+	}
+	out.Schedule = RuleSchedule{
+		ActivationWindows: convertSlice(in.Schedule.ActivationWindows, func(in *v1beta1.ActivationWindow, out *ActivationWindow) {
+			*out = ActivationWindow{Start: in.Start, DurationSeconds: in.DurationSeconds}
+		}),
+		TimeZone:  in.Schedule.TimeZone,
+		ExpiresAt: in.Schedule.ExpiresAt,
+	}
+	out.Drain = Drain{
+		AfterSeconds:          in.Drain.AfterSeconds,
+		MaxEvictionsPerMinute: in.Drain.MaxEvictionsPerMinute,
+	}
+}
+
+func convertConditionRequirementToV1beta1(in *ConditionRequirement, out *v1beta1.ConditionRequirement) {
+	out.Type = in.Type
+	out.RequiredStatus = in.RequiredStatus
+	out.DefaultStatus = in.GetDefaultStatus()
+}
+
+func convertConditionRequirementFromV1beta1(in *v1beta1.ConditionRequirement, out *ConditionRequirement) {
+	out.Type = in.Type
+	out.RequiredStatus = in.RequiredStatus
+	out.DefaultStatus = in.DefaultStatus
+	if out.DefaultStatus == corev1.ConditionUnknown {
+		out.DefaultStatus = ""
+	}
+}
+
+func convertStatusToV1beta1(in *NodeReadinessRuleStatus, out *v1beta1.NodeReadinessRuleStatus) {
+	out.Conditions = in.Conditions
+	out.ObservedGeneration = in.ObservedGeneration
+	out.AppliedNodes = in.AppliedNodes
+	out.FailedNodes = convertSlice(in.FailedNodes, func(in *NodeFailure, out *v1beta1.NodeFailure) {
+		*out = v1beta1.NodeFailure{
+			NodeName:           in.NodeName,
+			Reason:             in.Reason,
+			Message:            in.Message,
+			LastEvaluationTime: in.LastEvaluationTime,
+		}
+	})
+	out.NodeEvaluations = convertSlice(in.NodeEvaluations, convertNodeEvaluationToV1beta1)
+	out.NodeCounts = v1beta1.RuleNodeCounts{
+		Matched:            in.NodeCounts.Matched,
+		Held:               in.NodeCounts.Held,
+		Released:           in.NodeCounts.Released,
+		Failed:             in.NodeCounts.Failed,
+		BootstrapCompleted: in.NodeCounts.BootstrapCompleted,
+	}
+	out.Rollout = v1beta1.RolloutStatus{
+		CurrentStage:      in.Rollout.CurrentStage,
+		Percent:           in.Rollout.Percent,
+		Phase:             v1beta1.RolloutPhase(in.Rollout.Phase),
+		StageStartTime:    in.Rollout.StageStartTime,
+		EnforcedNodes:     in.Rollout.EnforcedNodes,
+		FailingNodes:      in.Rollout.FailingNodes,
+		NewlyTaintedNodes: in.Rollout.NewlyTaintedNodes,
+		Message:           in.Rollout.Message,
+	}
+	out.ScheduleState = v1beta1.ScheduleState(in.ScheduleState)
+	out.NextTransitionTime = in.NextTransitionTime
+	out.DryRunResults = v1beta1.DryRunResults{
+		AffectedNodes:    in.DryRunResults.AffectedNodes,
+		TaintsToAdd:      in.DryRunResults.TaintsToAdd,
+		TaintsToRemove:   in.DryRunResults.TaintsToRemove,
+		TaintsToEscalate: in.DryRunResults.TaintsToEscalate,
+		OutOfScopeNodes:  in.DryRunResults.OutOfScopeNodes,
+		RiskyOperations:  in.DryRunResults.RiskyOperations,
+		Summary:          in.DryRunResults.Summary,
+		Nodes: convertSlice(in.DryRunResults.Nodes, func(in *DryRunNode, out *v1beta1.DryRunNode) {
+			*out = v1beta1.DryRunNode{
+				NodeName:          in.NodeName,
+				Action:            v1beta1.DryRunAction(in.Action),
+				FailingConditions: in.FailingConditions,
+				MissingConditions: in.MissingConditions,
+			}
+		}),
+	}
+	out.DryRunStartTime = in.DryRunStartTime
+}
+
+func convertStatusFromV1beta1(in *v1beta1.NodeReadinessRuleStatus, out *NodeReadinessRuleStatus) {
+	out.Conditions = in.Conditions
+	out.ObservedGeneration = in.ObservedGeneration
+	out.AppliedNodes = in.AppliedNodes
+	out.FailedNodes = convertSlice(in.FailedNodes, func(in *v1beta1.NodeFailure, out *NodeFailure) {
+		*out = NodeFailure{
+			NodeName:           in.NodeName,
+			Reason:             in.Reason,
+			Message:            in.Message,
+			LastEvaluationTime: in.LastEvaluationTime,
+		}
+	})
+	out.NodeEvaluations = convertSlice(in.NodeEvaluations, convertNodeEvaluationFromV1beta1)
+	out.NodeCounts = RuleNodeCounts{
+		Matched:            in.NodeCounts.Matched,
+		Held:               in.NodeCounts.Held,
+		Released:           in.NodeCounts.Released,
+		Failed:             in.NodeCounts.Failed,
+		BootstrapCompleted: in.NodeCounts.BootstrapCompleted,
+	}
+	out.Rollout = RolloutStatus{
+		CurrentStage:      in.Rollout.CurrentStage,
+		Percent:           in.Rollout.Percent,
+		Phase:             RolloutPhase(in.Rollout.Phase),
+		StageStartTime:    in.Rollout.StageStartTime,
+		EnforcedNodes:     in.Rollout.EnforcedNodes,
+		FailingNodes:      in.Rollout.FailingNodes,
+		NewlyTaintedNodes: in.Rollout.NewlyTaintedNodes,
+		Message:           in.Rollout.Message,
+	}
+	out.ScheduleState = ScheduleState(in.ScheduleState)
+	out.NextTransitionTime = in.NextTransitionTime
+	out.DryRunResults = DryRunResults{
+		AffectedNodes:    in.DryRunResults.AffectedNodes,
+		TaintsToAdd:      in.DryRunResults.TaintsToAdd,
+		TaintsToRemove:   in.DryRunResults.TaintsToRemove,
+		TaintsToEscalate: in.DryRunResults.TaintsToEscalate,
+		OutOfScopeNodes:  in.DryRunResults.OutOfScopeNodes,
+		RiskyOperations:  in.DryRunResults.RiskyOperations,
+		Summary:          in.DryRunResults.Summary,
+		Nodes: convertSlice(in.DryRunResults.Nodes, func(in *v1beta1.DryRunNode, out *DryRunNode) {
+			*out = DryRunNode{
+				NodeName:          in.NodeName,
+				Action:            DryRunAction(in.Action),
+				FailingConditions: in.FailingConditions,
+				MissingConditions: in.MissingConditions,
+			}
+		}),
+	}
+	out.DryRunStartTime = in.DryRunStartTime
+}
+
+func convertNodeEvaluationToV1beta1(in *NodeEvaluation, out *v1beta1.NodeEvaluation) {
+	out.NodeName = in.NodeName
+	out.ConditionResults = convertSlice(in.ConditionResults, func(in *ConditionEvaluationResult, out *v1beta1.ConditionEvaluationResult) {
+		*out = v1beta1.ConditionEvaluationResult{
+			Type:           in.Type,
+			CurrentStatus:  in.CurrentStatus,
+			RequiredStatus: in.RequiredStatus,
+			DefaultStatus:  in.DefaultStatus,
+		}
+	})
+	out.TaintStatus = v1beta1.TaintStatus(in.TaintStatus)
+	out.LastEvaluationTime = in.LastEvaluationTime
+	out.Override = v1beta1.NodeOverride{
+		Action:         v1beta1.OverrideAction(in.Override.Action),
+		Annotation:     in.Override.Annotation,
+		ExpirationTime: in.Override.ExpirationTime,
+	}
+	out.Flap = v1beta1.FlapState{
+		LastResult:          v1beta1.EvaluationResult(in.Flap.LastResult),
+		Transitions:         in.Flap.Transitions,
+		WindowStartTime:     in.Flap.WindowStartTime,
+		LastTransitionTime:  in.Flap.LastTransitionTime,
+		QuarantineStartTime: in.Flap.QuarantineStartTime,
+	}
+	out.Drain = v1beta1.NodeDrainStatus{
+		Phase:         v1beta1.DrainPhase(in.Drain.Phase),
+		StartTime:     in.Drain.StartTime,
+		PodsEvicted:   in.Drain.PodsEvicted,
+		PodsRemaining: in.Drain.PodsRemaining,
+		Message:       in.Drain.Message,
+	}
+	out.TaintAdoption = v1beta1.TaintAdoption(in.TaintAdoption)
+	out.TaintAddedTime = in.TaintAddedTime
+	out.EscalationStartTime = in.EscalationStartTime
+	out.Transitions = convertSlice(in.Transitions, func(in *NodeTransition, out *v1beta1.NodeTransition) {
+		*out = v1beta1.NodeTransition{
+			Type:            v1beta1.NodeTransitionType(in.Type),
+			Time:            in.Time,
+			ConditionType:   in.ConditionType,
+			ConditionStatus: in.ConditionStatus,
+			Reason:          in.Reason,
+			Message:         in.Message,
+		}
+	})
+}
+
+func convertNodeEvaluationFromV1beta1(in *v1beta1.NodeEvaluation, out *NodeEvaluation) {
+	out.NodeName = in.NodeName
+	out.ConditionResults = convertSlice(in.ConditionResults, func(in *v1beta1.ConditionEvaluationResult, out *ConditionEvaluationResult) {
+		*out = ConditionEvaluationResult{
+			Type:           in.Type,
+			CurrentStatus:  in.CurrentStatus,
+			RequiredStatus: in.RequiredStatus,
+			DefaultStatus:  in.DefaultStatus,
+		}
+	})
+	out.TaintStatus = TaintStatus(in.TaintStatus)
+	out.LastEvaluationTime = in.LastEvaluationTime
+	out.Override = NodeOverride{
+		Action:         OverrideAction(in.Override.Action),
+		Annotation:     in.Override.Annotation,
+		ExpirationTime: in.Override.ExpirationTime,
+	}
+	out.Flap = FlapState{
+		LastResult:          EvaluationResult(in.Flap.LastResult),
+		Transitions:         in.Flap.Transitions,
+		WindowStartTime:     in.Flap.WindowStartTime,
+		LastTransitionTime:  in.Flap.LastTransitionTime,
+		QuarantineStartTime: in.Flap.QuarantineStartTime,
+	}
+	out.Drain = NodeDrainStatus{
+		Phase:         DrainPhase(in.Drain.Phase),
+		StartTime:     in.Drain.StartTime,
+		PodsEvicted:   in.Drain.PodsEvicted,
+		PodsRemaining: in.Drain.PodsRemaining,
+		Message:       in.Drain.Message,
+	}
+	out.TaintAdoption = TaintAdoption(in.TaintAdoption)
+	out.TaintAddedTime = in.TaintAddedTime
+	out.EscalationStartTime = in.EscalationStartTime
+	out.Transitions = convertSlice(in.Transitions, func(in *v1beta1.NodeTransition, out *NodeTransition) {
+		*out = NodeTransition{
+			Type:            NodeTransitionType(in.Type),
+			Time:            in.Time,
+			ConditionType:   in.ConditionType,
+			ConditionStatus: in.ConditionStatus,
+			Reason:          in.Reason,
+			Message:         in.Message,
+		}
+	})
+}
@@ -0,0 +1,192 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+	"sigs.k8s.io/randfill"
+
+	"sigs.k8s.io/node-readiness-controller/api/v1beta1"
+)
+
+const fuzzIterations = 1000
+
+// conditionStatuses are the values of the status fields of ConditionRequirement.
+var conditionStatuses = []corev1.ConditionStatus{corev1.ConditionTrue, corev1.ConditionFalse, corev1.ConditionUnknown}
+
+// spokeFuzzerFuncs only generates v1alpha1 objects in the form they are
+// stored in: defaults that v1beta1 makes explicit are omitted.
+func spokeFuzzerFuncs(_ serializer.CodecFactory) []any {
+	return []any{
+		func(spec *NodeReadinessRuleSpec, c randfill.Continue) {
+			c.FillNoCustom(spec)
+			spec.EnforcementMode = []EnforcementMode{EnforcementModeBootstrapOnly, EnforcementModeContinuous}[c.Intn(2)]
+			spec.ConditionPolicy = []ConditionPolicy{"", ConditionPolicyAnyOf}[c.Intn(2)]
+		},
+		func(cond *ConditionRequirement, c randfill.Continue) {
+			c.FillNoCustom(cond)
+			cond.DefaultStatus = []corev1.ConditionStatus{"", corev1.ConditionTrue, corev1.ConditionFalse}[c.Intn(3)]
+		},
+	}
+}
+
+// hubFuzzerFuncs only generates v1beta1 objects that pass validation of the
+// fields v1alpha1 handles differently.
+func hubFuzzerFuncs(_ serializer.CodecFactory) []any {
+	return []any{
+		func(spec *v1beta1.NodeReadinessRuleSpec, c randfill.Continue) {
+			c.FillNoCustom(spec)
+			spec.EnforcementMode = []v1beta1.EnforcementMode{v1beta1.EnforcementModeBootstrapOnly, v1beta1.EnforcementModeContinuous}[c.Intn(2)]
+			spec.ConditionPolicy = []v1beta1.ConditionPolicy{v1beta1.ConditionPolicyAllOf, v1beta1.ConditionPolicyAnyOf}[c.Intn(2)]
+		},
+		func(cond *v1beta1.ConditionRequirement, c randfill.Continue) {
+			c.FillNoCustom(cond)
+			cond.DefaultStatus = conditionStatuses[c.Intn(len(conditionStatuses))]
+		},
+	}
+}
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+func newFuzzer(t *testing.T, funcs fuzzer.FuzzerFuncs) *randfill.Filler {
+	scheme := newScheme(t)
+	seed := rand.Int63()
+	t.Logf("fuzzer seed: %d", seed)
+	return fuzzer.FuzzerFor(fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, funcs), rand.NewSource(seed), serializer.NewCodecFactory(scheme))
+}
+
+func TestNodeReadinessRuleIsConvertible(t *testing.T) {
+	g := NewWithT(t)
+
+	convertible, err := conversion.IsConvertible(newScheme(t), &NodeReadinessRule{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(convertible).To(BeTrue())
+}
+
+func TestNodeReadinessRuleSpokeHubSpokeRoundTrip(t *testing.T) {
+	f := newFuzzer(t, spokeFuzzerFuncs)
+	for range fuzzIterations {
+		original := &NodeReadinessRule{}
+		f.Fill(original)
+		original.TypeMeta = metav1.TypeMeta{}
+
+		hub := &v1beta1.NodeReadinessRule{}
+		if err := original.DeepCopy().ConvertTo(hub); err != nil {
+			t.Fatalf("ConvertTo: %v", err)
+		}
+		roundTripped := &NodeReadinessRule{}
+		if err := roundTripped.ConvertFrom(hub); err != nil {
+			t.Fatalf("ConvertFrom: %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+			t.Fatalf("v1alpha1 -> v1beta1 -> v1alpha1 is not lossless:\n%s", diff.Diff(original, roundTripped))
+		}
+	}
+}
+
+func TestNodeReadinessRuleHubSpokeHubRoundTrip(t *testing.T) {
+	f := newFuzzer(t, hubFuzzerFuncs)
+	for range fuzzIterations {
+		original := &v1beta1.NodeReadinessRule{}
+		f.Fill(original)
+		original.TypeMeta = metav1.TypeMeta{}
+
+		spoke := &NodeReadinessRule{}
+		if err := spoke.ConvertFrom(original.DeepCopy()); err != nil {
+			t.Fatalf("ConvertFrom: %v", err)
+		}
+		roundTripped := &v1beta1.NodeReadinessRule{}
+		if err := spoke.ConvertTo(roundTripped); err != nil {
+			t.Fatalf("ConvertTo: %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+			t.Fatalf("v1beta1 -> v1alpha1 -> v1beta1 is not lossless:\n%s", diff.Diff(original, roundTripped))
+		}
+	}
+}
+
+func TestNodeReadinessRuleConvertTo(t *testing.T) {
+	g := NewWithT(t)
+
+	rule := &NodeReadinessRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "cni-readiness"},
+		Spec: NodeReadinessRuleSpec{
+			Conditions: []ConditionRequirement{
+				{Type: "NetworkReady", RequiredStatus: corev1.ConditionTrue},
+				{Type: "StorageReady", RequiredStatus: corev1.ConditionTrue, DefaultStatus: corev1.ConditionFalse},
+			},
+			EnforcementMode: EnforcementModeBootstrapOnly,
+		},
+		Status: NodeReadinessRuleStatus{
+			ObservedGeneration: 3,
+			NodeEvaluations:    []NodeEvaluation{{NodeName: "node-1", TaintStatus: TaintStatusAbsent}},
+		},
+	}
+
+	hub := &v1beta1.NodeReadinessRule{}
+	g.Expect(rule.ConvertTo(hub)).To(Succeed())
+	g.Expect(hub.Name).To(Equal("cni-readiness"))
+	g.Expect(hub.Spec.EnforcementMode).To(Equal(v1beta1.EnforcementModeBootstrapOnly))
+	g.Expect(hub.Spec.ConditionPolicy).To(Equal(v1beta1.ConditionPolicyAllOf))
+	g.Expect(hub.Spec.Conditions[0].DefaultStatus).To(Equal(corev1.ConditionUnknown))
+	g.Expect(hub.Spec.Conditions[1].DefaultStatus).To(Equal(corev1.ConditionFalse))
+	g.Expect(hub.Status.ObservedGeneration).To(Equal(int64(3)))
+	g.Expect(hub.Status.NodeEvaluations).To(HaveLen(1))
+	g.Expect(hub.Status.NodeEvaluations[0].TaintStatus).To(Equal(v1beta1.TaintStatusAbsent))
+}
+
+func TestNodeReadinessRuleConvertFrom(t *testing.T) {
+	g := NewWithT(t)
+
+	hub := &v1beta1.NodeReadinessRule{
+		Spec: v1beta1.NodeReadinessRuleSpec{
+			Conditions: []v1beta1.ConditionRequirement{
+				{Type: "NetworkReady", RequiredStatus: corev1.ConditionTrue, DefaultStatus: corev1.ConditionUnknown},
+			},
+			EnforcementMode: v1beta1.EnforcementModeContinuous,
+			ConditionPolicy: v1beta1.ConditionPolicyAnyOf,
+		},
+	}
+
+	rule := &NodeReadinessRule{}
+	g.Expect(rule.ConvertFrom(hub)).To(Succeed())
+	g.Expect(rule.Spec.EnforcementMode).To(Equal(EnforcementModeContinuous))
+	g.Expect(rule.Spec.ConditionPolicy).To(Equal(ConditionPolicyAnyOf))
+	g.Expect(rule.Spec.Conditions[0].DefaultStatus).To(BeEmpty())
+	g.Expect(rule.Spec.Conditions[0].GetDefaultStatus()).To(Equal(corev1.ConditionUnknown))
+}
@@ -0,0 +1,212 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeReadinessRuleSimulationSpec defines the rule to simulate.
+type NodeReadinessRuleSimulationSpec struct {
+	// rule is the spec of the NodeReadinessRule to evaluate against the
+	// cluster's Nodes. The simulated rule is named after the simulation.
+	//
+	// +required
+	Rule NodeReadinessRuleSpec `json:"rule,omitempty,omitzero"`
+
+	// conditionOverrides replace the status of Node conditions for the
+	// simulation, to preview how the rule would act once they change. When
+	// several overrides set the same condition on a Node, the last one wins.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=32
+	ConditionOverrides []SimulatedCondition `json:"conditionOverrides,omitempty"`
+
+	// ttlSecondsAfterFinished is how long the simulation is kept once it has
+	// finished, after which the controller deletes it. When omitted, it is
+	// kept for an hour.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// SimulatedCondition sets the status of a condition on Nodes for a simulation.
+type SimulatedCondition struct {
+	// type of the Node condition.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	Type string `json:"type,omitempty"`
+
+	// status the condition is simulated with, one of True, False, Unknown.
+	//
+	// +required
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Status corev1.ConditionStatus `json:"status,omitempty"`
+
+	// nodeSelector limits the override to the Nodes it selects. When
+	// omitted, the override applies to all Nodes.
+	//
+	// +optional
+	NodeSelector metav1.LabelSelector `json:"nodeSelector,omitempty,omitzero"`
+}
+
+// Condition types of a NodeReadinessRuleSimulation.
+const (
+	// SimulationConditionComplete means the simulation evaluated the rule
+	// against the cluster's Nodes.
+	SimulationConditionComplete = "Complete"
+
+	// SimulationConditionFailed means the simulation could not evaluate the rule.
+	SimulationConditionFailed = "Failed"
+)
+
+// Condition reasons of a NodeReadinessRuleSimulation.
+const (
+	// SimulationReasonEvaluated means the rule was evaluated against the cluster's Nodes.
+	SimulationReasonEvaluated = "Evaluated"
+
+	// SimulationReasonInvalidNodeSelector means the nodeSelector of the rule
+	// or of a condition override cannot be parsed.
+	SimulationReasonInvalidNodeSelector = "InvalidNodeSelector"
+)
+
+// NodeReadinessRuleSimulationStatus defines the outcome of a simulation.
+type NodeReadinessRuleSimulationStatus struct {
+	// conditions represent the state of the simulation. Complete is true once
+	// the rule has been evaluated; Failed is true when it could not be.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// completionTime is when the simulation finished, from which its
+	// ttlSecondsAfterFinished is counted.
+	//
+	// +optional
+	CompletionTime metav1.Time `json:"completionTime,omitempty,omitzero"`
+
+	// results summarizes the changes the rule would make, as the status of a
+	// rule in dry run mode does.
+	//
+	// +optional
+	Results DryRunResults `json:"results,omitempty,omitzero"`
+
+	// nodes lists the outcome of the rule for each Node it selects and
+	// scopes, including those it would leave unchanged, by name. Only the
+	// first 1000 are listed.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=nodeName
+	// +kubebuilder:validation:MaxItems=1000
+	Nodes []SimulatedNode `json:"nodes,omitempty"`
+}
+
+// SimulatedNode is the outcome of a simulated rule for a Node.
+type SimulatedNode struct {
+	// nodeName is the name of the Node.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	NodeName string `json:"nodeName,omitempty"`
+
+	// taintStatus is whether the rule's taint would be on the Node once the
+	// rule has acted, one of Present, Absent.
+	//
+	// +required
+	TaintStatus TaintStatus `json:"taintStatus,omitempty"`
+
+	// action is the change the rule would make to the Node. It is omitted
+	// when the rule would leave the Node unchanged.
+	//
+	// +optional
+	Action DryRunAction `json:"action,omitempty"`
+
+	// failingConditions are the rule's conditions the Node does not meet.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MaxLength=316
+	FailingConditions []string `json:"failingConditions,omitempty"`
+
+	// missingConditions are the rule's conditions the Node does not report,
+	// for which their defaultStatus was assumed.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MaxLength=316
+	MissingConditions []string `json:"missingConditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=nrrsim
+// +kubebuilder:printcolumn:name="Complete",type=string,JSONPath=`.status.conditions[?(@.type=="Complete")].status`,description="Whether the rule has been evaluated."
+// +kubebuilder:printcolumn:name="Summary",type=string,JSONPath=`.status.results.summary`,description="The changes the rule would make."
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="The age of this resource"
+
+// NodeReadinessRuleSimulation evaluates a NodeReadinessRule spec once against
+// the cluster's Nodes, without tainting them or admitting a rule, and reports
+// what the rule would do. It is deleted once its TTL has expired.
+type NodeReadinessRuleSimulation struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	//
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the rule to simulate. It is immutable.
+	//
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+	Spec NodeReadinessRuleSimulationSpec `json:"spec,omitempty,omitzero"`
+
+	// status defines the outcome of the simulation
+	//
+	// +optional
+	Status NodeReadinessRuleSimulationStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeReadinessRuleSimulationList contains a list of NodeReadinessRuleSimulation.
+type NodeReadinessRuleSimulationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard list's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds
+	//
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// items is the list of NodeReadinessRuleSimulation.
+	Items []NodeReadinessRuleSimulation `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &NodeReadinessRuleSimulation{}, &NodeReadinessRuleSimulationList{})
+}
@@ -0,0 +1,171 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeReadinessStatusStatus defines the observed state of NodeReadinessStatus.
+type NodeReadinessStatusStatus struct {
+	// rules lists how each NodeReadinessRule that evaluated the Node last
+	// evaluated it.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=ruleName
+	// +kubebuilder:validation:MaxItems=1000
+	Rules []RuleEvaluation `json:"rules,omitempty"`
+}
+
+// RuleEvaluation is how a NodeReadinessRule last evaluated a Node.
+type RuleEvaluation struct {
+	// ruleName is the name of the NodeReadinessRule.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	RuleName string `json:"ruleName,omitempty"`
+
+	// evaluation is the rule's evaluation of the Node. It is omitted when the
+	// evaluation failed before completing.
+	//
+	// +optional
+	Evaluation NodeEvaluation `json:"evaluation,omitempty,omitzero"`
+
+	// failure describes why the last evaluation of the Node failed. It is
+	// omitted when the last evaluation succeeded.
+	//
+	// +optional
+	Failure NodeFailure `json:"failure,omitempty,omitzero"`
+
+	// transitions records the most recent changes of the rule's evaluation of
+	// the Node, oldest first. Once full, the oldest transitions are dropped.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=20
+	Transitions []NodeTransition `json:"transitions,omitempty"`
+}
+
+// NodeTransitionType is the kind of change a NodeTransition records.
+// +kubebuilder:validation:Enum=TaintAdded;TaintRemoved;ConditionChanged;EvaluationFailed;EvaluationRecovered
+type NodeTransitionType string
+
+const (
+	// NodeTransitionTaintAdded records the rule's taint being added to, or
+	// first held on, the Node.
+	NodeTransitionTaintAdded NodeTransitionType = "TaintAdded"
+
+	// NodeTransitionTaintRemoved records the rule's taint being removed from the Node.
+	NodeTransitionTaintRemoved NodeTransitionType = "TaintRemoved"
+
+	// NodeTransitionConditionChanged records a change of the status of one of
+	// the rule's conditions on the Node.
+	NodeTransitionConditionChanged NodeTransitionType = "ConditionChanged"
+
+	// NodeTransitionEvaluationFailed records the rule failing to evaluate the Node.
+	NodeTransitionEvaluationFailed NodeTransitionType = "EvaluationFailed"
+
+	// NodeTransitionEvaluationRecovered records the rule evaluating the Node
+	// again after failing to.
+	NodeTransitionEvaluationRecovered NodeTransitionType = "EvaluationRecovered"
+)
+
+// NodeTransition is a change of a rule's evaluation of a Node.
+type NodeTransition struct {
+	// type is the kind of change, one of TaintAdded, TaintRemoved,
+	// ConditionChanged, EvaluationFailed, EvaluationRecovered.
+	//
+	// +required
+	Type NodeTransitionType `json:"type,omitempty"`
+
+	// time is when the controller observed the change.
+	//
+	// +required
+	Time metav1.Time `json:"time,omitempty,omitzero"`
+
+	// conditionType is the Node condition whose status changed. It is only
+	// set for ConditionChanged transitions.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	ConditionType string `json:"conditionType,omitempty"`
+
+	// conditionStatus is the new status of the condition, one of True, False,
+	// Unknown. It is only set for ConditionChanged transitions.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	ConditionStatus corev1.ConditionStatus `json:"conditionStatus,omitempty"`
+
+	// reason is a brief, machine-readable explanation of the change.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Reason string `json:"reason,omitempty"`
+
+	// message is a human-readable description of the change.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=nrs
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="The age of this resource"
+
+// NodeReadinessStatus records how NodeReadinessRules evaluated a Node. It is
+// named after the Node and owned by it, so that it is deleted with the Node.
+type NodeReadinessStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	//
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// status defines the observed state of NodeReadinessStatus
+	//
+	// +optional
+	Status NodeReadinessStatusStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeReadinessStatusList contains a list of NodeReadinessStatus.
+type NodeReadinessStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard list's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds
+	//
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// items is the list of NodeReadinessStatus.
+	Items []NodeReadinessStatus `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &NodeReadinessStatus{}, &NodeReadinessStatusList{})
+}
@@ -21,9 +21,25 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivationWindow) DeepCopyInto(out *ActivationWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivationWindow.
+func (in *ActivationWindow) DeepCopy() *ActivationWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivationWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionEvaluationResult) DeepCopyInto(out *ConditionEvaluationResult) {
 	*out = *in
@@ -54,6 +70,46 @@ func (in *ConditionRequirement) DeepCopy() *ConditionRequirement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Drain) DeepCopyInto(out *Drain) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Drain.
+func (in *Drain) DeepCopy() *Drain {
+	if in == nil {
+		return nil
+	}
+	out := new(Drain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunNode) DeepCopyInto(out *DryRunNode) {
+	*out = *in
+	if in.FailingConditions != nil {
+		in, out := &in.FailingConditions, &out.FailingConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MissingConditions != nil {
+		in, out := &in.MissingConditions, &out.MissingConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunNode.
+func (in *DryRunNode) DeepCopy() *DryRunNode {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DryRunResults) DeepCopyInto(out *DryRunResults) {
 	*out = *in
@@ -72,11 +128,28 @@ func (in *DryRunResults) DeepCopyInto(out *DryRunResults) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.TaintsToEscalate != nil {
+		in, out := &in.TaintsToEscalate, &out.TaintsToEscalate
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OutOfScopeNodes != nil {
+		in, out := &in.OutOfScopeNodes, &out.OutOfScopeNodes
+		*out = new(int32)
+		**out = **in
+	}
 	if in.RiskyOperations != nil {
 		in, out := &in.RiskyOperations, &out.RiskyOperations
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]DryRunNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunResults.
@@ -89,6 +162,70 @@ func (in *DryRunResults) DeepCopy() *DryRunResults {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlapDetection) DeepCopyInto(out *FlapDetection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlapDetection.
+func (in *FlapDetection) DeepCopy() *FlapDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(FlapDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlapState) DeepCopyInto(out *FlapState) {
+	*out = *in
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = new(int32)
+		**out = **in
+	}
+	in.WindowStartTime.DeepCopyInto(&out.WindowStartTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	in.QuarantineStartTime.DeepCopyInto(&out.QuarantineStartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlapState.
+func (in *FlapState) DeepCopy() *FlapState {
+	if in == nil {
+		return nil
+	}
+	out := new(FlapState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeDrainStatus) DeepCopyInto(out *NodeDrainStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.PodsEvicted != nil {
+		in, out := &in.PodsEvicted, &out.PodsEvicted
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PodsRemaining != nil {
+		in, out := &in.PodsRemaining, &out.PodsRemaining
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeDrainStatus.
+func (in *NodeDrainStatus) DeepCopy() *NodeDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeEvaluation) DeepCopyInto(out *NodeEvaluation) {
 	*out = *in
@@ -98,6 +235,18 @@ func (in *NodeEvaluation) DeepCopyInto(out *NodeEvaluation) {
 		copy(*out, *in)
 	}
 	in.LastEvaluationTime.DeepCopyInto(&out.LastEvaluationTime)
+	in.Override.DeepCopyInto(&out.Override)
+	in.Flap.DeepCopyInto(&out.Flap)
+	in.Drain.DeepCopyInto(&out.Drain)
+	in.TaintAddedTime.DeepCopyInto(&out.TaintAddedTime)
+	in.EscalationStartTime.DeepCopyInto(&out.EscalationStartTime)
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]NodeTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeEvaluation.
@@ -126,6 +275,22 @@ func (in *NodeFailure) DeepCopy() *NodeFailure {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeOverride) DeepCopyInto(out *NodeOverride) {
+	*out = *in
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeOverride.
+func (in *NodeOverride) DeepCopy() *NodeOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeReadinessRule) DeepCopyInto(out *NodeReadinessRule) {
 	*out = *in
@@ -185,6 +350,124 @@ func (in *NodeReadinessRuleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReadinessRuleSimulation) DeepCopyInto(out *NodeReadinessRuleSimulation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessRuleSimulation.
+func (in *NodeReadinessRuleSimulation) DeepCopy() *NodeReadinessRuleSimulation {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReadinessRuleSimulation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeReadinessRuleSimulation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReadinessRuleSimulationList) DeepCopyInto(out *NodeReadinessRuleSimulationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeReadinessRuleSimulation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessRuleSimulationList.
+func (in *NodeReadinessRuleSimulationList) DeepCopy() *NodeReadinessRuleSimulationList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReadinessRuleSimulationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeReadinessRuleSimulationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReadinessRuleSimulationSpec) DeepCopyInto(out *NodeReadinessRuleSimulationSpec) {
+	*out = *in
+	in.Rule.DeepCopyInto(&out.Rule)
+	if in.ConditionOverrides != nil {
+		in, out := &in.ConditionOverrides, &out.ConditionOverrides
+		*out = make([]SimulatedCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessRuleSimulationSpec.
+func (in *NodeReadinessRuleSimulationSpec) DeepCopy() *NodeReadinessRuleSimulationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReadinessRuleSimulationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReadinessRuleSimulationStatus) DeepCopyInto(out *NodeReadinessRuleSimulationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
+	in.Results.DeepCopyInto(&out.Results)
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]SimulatedNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessRuleSimulationStatus.
+func (in *NodeReadinessRuleSimulationStatus) DeepCopy() *NodeReadinessRuleSimulationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReadinessRuleSimulationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeReadinessRuleSpec) DeepCopyInto(out *NodeReadinessRuleSpec) {
 	*out = *in
@@ -195,6 +478,21 @@ func (in *NodeReadinessRuleSpec) DeepCopyInto(out *NodeReadinessRuleSpec) {
 	}
 	in.Taint.DeepCopyInto(&out.Taint)
 	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+	out.NodeScope = in.NodeScope
+	if in.BootstrapRearmTriggers != nil {
+		in, out := &in.BootstrapRearmTriggers, &out.BootstrapRearmTriggers
+		*out = make([]BootstrapRearmTrigger, len(*in))
+		copy(*out, *in)
+	}
+	out.FlapDetection = in.FlapDetection
+	if in.TaintEscalation != nil {
+		in, out := &in.TaintEscalation, &out.TaintEscalation
+		*out = make([]TaintEscalationStep, len(*in))
+		copy(*out, *in)
+	}
+	in.Rollout.DeepCopyInto(&out.Rollout)
+	in.Schedule.DeepCopyInto(&out.Schedule)
+	out.Drain = in.Drain
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessRuleSpec.
@@ -210,6 +508,13 @@ func (in *NodeReadinessRuleSpec) DeepCopy() *NodeReadinessRuleSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeReadinessRuleStatus) DeepCopyInto(out *NodeReadinessRuleStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AppliedNodes != nil {
 		in, out := &in.AppliedNodes, &out.AppliedNodes
 		*out = make([]string, len(*in))
@@ -229,7 +534,11 @@ func (in *NodeReadinessRuleStatus) DeepCopyInto(out *NodeReadinessRuleStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.NodeCounts.DeepCopyInto(&out.NodeCounts)
+	in.Rollout.DeepCopyInto(&out.Rollout)
+	in.NextTransitionTime.DeepCopyInto(&out.NextTransitionTime)
 	in.DryRunResults.DeepCopyInto(&out.DryRunResults)
+	in.DryRunStartTime.DeepCopyInto(&out.DryRunStartTime)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessRuleStatus.
@@ -241,3 +550,331 @@ func (in *NodeReadinessRuleStatus) DeepCopy() *NodeReadinessRuleStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReadinessStatus) DeepCopyInto(out *NodeReadinessStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessStatus.
+func (in *NodeReadinessStatus) DeepCopy() *NodeReadinessStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReadinessStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeReadinessStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReadinessStatusList) DeepCopyInto(out *NodeReadinessStatusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeReadinessStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessStatusList.
+func (in *NodeReadinessStatusList) DeepCopy() *NodeReadinessStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReadinessStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeReadinessStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReadinessStatusStatus) DeepCopyInto(out *NodeReadinessStatusStatus) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RuleEvaluation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessStatusStatus.
+func (in *NodeReadinessStatusStatus) DeepCopy() *NodeReadinessStatusStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReadinessStatusStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeScope) DeepCopyInto(out *NodeScope) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeScope.
+func (in *NodeScope) DeepCopy() *NodeScope {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTransition) DeepCopyInto(out *NodeTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTransition.
+func (in *NodeTransition) DeepCopy() *NodeTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rollout) DeepCopyInto(out *Rollout) {
+	*out = *in
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]RolloutStage, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxFailurePercent != nil {
+		in, out := &in.MaxFailurePercent, &out.MaxFailurePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxNewlyTaintedNodes != nil {
+		in, out := &in.MaxNewlyTaintedNodes, &out.MaxNewlyTaintedNodes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rollout.
+func (in *Rollout) DeepCopy() *Rollout {
+	if in == nil {
+		return nil
+	}
+	out := new(Rollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStage) DeepCopyInto(out *RolloutStage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStage.
+func (in *RolloutStage) DeepCopy() *RolloutStage {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	in.StageStartTime.DeepCopyInto(&out.StageStartTime)
+	if in.EnforcedNodes != nil {
+		in, out := &in.EnforcedNodes, &out.EnforcedNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailingNodes != nil {
+		in, out := &in.FailingNodes, &out.FailingNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NewlyTaintedNodes != nil {
+		in, out := &in.NewlyTaintedNodes, &out.NewlyTaintedNodes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleEvaluation) DeepCopyInto(out *RuleEvaluation) {
+	*out = *in
+	in.Evaluation.DeepCopyInto(&out.Evaluation)
+	in.Failure.DeepCopyInto(&out.Failure)
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]NodeTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleEvaluation.
+func (in *RuleEvaluation) DeepCopy() *RuleEvaluation {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleEvaluation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleNodeCounts) DeepCopyInto(out *RuleNodeCounts) {
+	*out = *in
+	if in.Matched != nil {
+		in, out := &in.Matched, &out.Matched
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Held != nil {
+		in, out := &in.Held, &out.Held
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Released != nil {
+		in, out := &in.Released, &out.Released
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BootstrapCompleted != nil {
+		in, out := &in.BootstrapCompleted, &out.BootstrapCompleted
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleNodeCounts.
+func (in *RuleNodeCounts) DeepCopy() *RuleNodeCounts {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleNodeCounts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleSchedule) DeepCopyInto(out *RuleSchedule) {
+	*out = *in
+	if in.ActivationWindows != nil {
+		in, out := &in.ActivationWindows, &out.ActivationWindows
+		*out = make([]ActivationWindow, len(*in))
+		copy(*out, *in)
+	}
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleSchedule.
+func (in *RuleSchedule) DeepCopy() *RuleSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SimulatedCondition) DeepCopyInto(out *SimulatedCondition) {
+	*out = *in
+	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SimulatedCondition.
+func (in *SimulatedCondition) DeepCopy() *SimulatedCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulatedCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SimulatedNode) DeepCopyInto(out *SimulatedNode) {
+	*out = *in
+	if in.FailingConditions != nil {
+		in, out := &in.FailingConditions, &out.FailingConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MissingConditions != nil {
+		in, out := &in.MissingConditions, &out.MissingConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SimulatedNode.
+func (in *SimulatedNode) DeepCopy() *SimulatedNode {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulatedNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaintEscalationStep) DeepCopyInto(out *TaintEscalationStep) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaintEscalationStep.
+func (in *TaintEscalationStep) DeepCopy() *TaintEscalationStep {
+	if in == nil {
+		return nil
+	}
+	out := new(TaintEscalationStep)
+	in.DeepCopyInto(out)
+	return out
+}
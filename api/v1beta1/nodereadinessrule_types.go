@@ -0,0 +1,1458 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EnforcementMode specifies how the controller maintains the desired state.
+// +kubebuilder:validation:Enum=BootstrapOnly;Continuous
+type EnforcementMode string
+
+const (
+	// EnforcementModeBootstrapOnly applies configuration only during the first reconcile.
+	EnforcementModeBootstrapOnly EnforcementMode = "BootstrapOnly"
+
+	// EnforcementModeContinuous continuously monitors and enforces the configuration.
+	EnforcementModeContinuous EnforcementMode = "Continuous"
+)
+
+// ConditionPolicy defines how the list of conditions is aggregated when evaluating a rule.
+// +kubebuilder:validation:Enum=AllOf;AnyOf
+type ConditionPolicy string
+
+const (
+	// ConditionPolicyAllOf requires ALL conditions to match their requiredStatus.
+	ConditionPolicyAllOf ConditionPolicy = "AllOf"
+
+	// ConditionPolicyAnyOf requires at least ONE condition to match its requiredStatus.
+	ConditionPolicyAnyOf ConditionPolicy = "AnyOf"
+)
+
+// NodeExitPolicy defines what happens when a Node stops matching a rule's nodeSelector.
+// +kubebuilder:validation:Enum=RemoveTaint;KeepTaint
+type NodeExitPolicy string
+
+const (
+	// NodeExitPolicyRemoveTaint removes the rule's taint from the Node and drops it from the rule's status (default).
+	NodeExitPolicyRemoveTaint NodeExitPolicy = "RemoveTaint"
+
+	// NodeExitPolicyKeepTaint leaves the rule's taint and the Node's status entry in place.
+	NodeExitPolicyKeepTaint NodeExitPolicy = "KeepTaint"
+)
+
+// TaintAdoptionPolicy defines what a rule does with its taint when it first
+// evaluates a Node that already carries it.
+// +kubebuilder:validation:Enum=Adopt;Refuse;Replace
+type TaintAdoptionPolicy string
+
+const (
+	// TaintAdoptionPolicyAdopt takes over the existing taint as is (default).
+	TaintAdoptionPolicyAdopt TaintAdoptionPolicy = "Adopt"
+
+	// TaintAdoptionPolicyRefuse leaves the Node untouched until the existing taint is removed.
+	TaintAdoptionPolicyRefuse TaintAdoptionPolicy = "Refuse"
+
+	// TaintAdoptionPolicyReplace removes the existing taint and applies the rule's taint afresh.
+	TaintAdoptionPolicyReplace TaintAdoptionPolicy = "Replace"
+)
+
+// TaintAdoption is what a rule did with the taint a Node already carried when
+// the rule first evaluated it.
+// +kubebuilder:validation:Enum=Adopted;Refused;Replaced
+type TaintAdoption string
+
+const (
+	// TaintAdoptionAdopted means the existing taint was taken over as is.
+	TaintAdoptionAdopted TaintAdoption = "Adopted"
+
+	// TaintAdoptionRefused means the rule does not manage the Node while the existing taint is present.
+	TaintAdoptionRefused TaintAdoption = "Refused"
+
+	// TaintAdoptionReplaced means the existing taint was replaced by the rule's taint.
+	TaintAdoptionReplaced TaintAdoption = "Replaced"
+)
+
+// DeletionPolicy defines what happens to a rule's taints when the rule is deleted.
+// +kubebuilder:validation:Enum=Delete;Retain;OrphanToRule
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete removes the rule's taint from every Node it selects (default).
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// DeletionPolicyRetain leaves the rule's taint on every Node.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+
+	// DeletionPolicyOrphanToRule hands the rule's taint over to a successor rule that manages the same taint.
+	DeletionPolicyOrphanToRule DeletionPolicy = "OrphanToRule"
+)
+
+// BootstrapRearmTrigger is an event that makes a bootstrap-only rule bootstrap a Node again.
+// +kubebuilder:validation:Enum=NodeReboot;KubeletUpgrade;Annotation
+type BootstrapRearmTrigger string
+
+const (
+	// BootstrapRearmTriggerNodeReboot re-arms bootstrap when the Node's status.nodeInfo.bootID changes.
+	BootstrapRearmTriggerNodeReboot BootstrapRearmTrigger = "NodeReboot"
+
+	// BootstrapRearmTriggerKubeletUpgrade re-arms bootstrap when the Node's status.nodeInfo.kubeletVersion changes.
+	BootstrapRearmTriggerKubeletUpgrade BootstrapRearmTrigger = "KubeletUpgrade"
+
+	// BootstrapRearmTriggerAnnotation re-arms bootstrap when the Node is annotated with readiness.k8s.io/rearm-bootstrap.
+	BootstrapRearmTriggerAnnotation BootstrapRearmTrigger = "Annotation"
+)
+
+// BootstrapAdoptionPolicy defines which existing bootstrap completions a rule adopts.
+// +kubebuilder:validation:Enum=None;SameRuleName
+type BootstrapAdoptionPolicy string
+
+const (
+	// BootstrapAdoptionPolicyNone only trusts completions recorded under the rule's own UID or bootstrapID (default).
+	BootstrapAdoptionPolicyNone BootstrapAdoptionPolicy = "None"
+
+	// BootstrapAdoptionPolicySameRuleName also trusts completions recorded by a previous rule with the same name.
+	BootstrapAdoptionPolicySameRuleName BootstrapAdoptionPolicy = "SameRuleName"
+)
+
+// NodeScopeType defines which existing Nodes a bootstrap-only rule applies to.
+// +kubebuilder:validation:Enum=CreatedAfterRule;MaxAge
+type NodeScopeType string
+
+const (
+	// NodeScopeTypeCreatedAfterRule applies the rule to Nodes created after the rule.
+	NodeScopeTypeCreatedAfterRule NodeScopeType = "CreatedAfterRule"
+
+	// NodeScopeTypeMaxAge applies the rule to Nodes that were younger than maxAgeSeconds when the rule was created.
+	NodeScopeTypeMaxAge NodeScopeType = "MaxAge"
+)
+
+// ScheduleState reports whether a rule with a schedule is currently active.
+// +kubebuilder:validation:Enum=Active;Inactive
+type ScheduleState string
+
+const (
+	// ScheduleStateActive means the rule is within one of its activation windows.
+	ScheduleStateActive ScheduleState = "Active"
+
+	// ScheduleStateInactive means the rule is outside its activation windows and suspended.
+	ScheduleStateInactive ScheduleState = "Inactive"
+)
+
+// RolloutPhase is the progress of a rule's rollout.
+// +kubebuilder:validation:Enum=Progressing;Blocked;Completed
+type RolloutPhase string
+
+const (
+	// RolloutPhaseProgressing means the current stage is in effect and the rollout advances once its pause has elapsed.
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+
+	// RolloutPhaseBlocked means the rollout does not advance because a threshold is exceeded.
+	RolloutPhaseBlocked RolloutPhase = "Blocked"
+
+	// RolloutPhaseCompleted means the last stage is in effect.
+	RolloutPhaseCompleted RolloutPhase = "Completed"
+)
+
+// Condition types of a NodeReadinessRule.
+const (
+	// RuleConditionReady means the controller has reconciled the latest spec
+	// of the rule and it is not degraded.
+	RuleConditionReady = "Ready"
+
+	// RuleConditionProgressing means the controller has not reconciled the
+	// latest spec of the rule yet, or its rollout has not completed.
+	RuleConditionProgressing = "Progressing"
+
+	// RuleConditionDegraded means the rule cannot be evaluated for some of
+	// its Nodes, or its rollout is blocked.
+	RuleConditionDegraded = "Degraded"
+)
+
+// Condition reasons of a NodeReadinessRule.
+const (
+	// RuleReasonEnforcing means the rule manages the taint of the Nodes it selects.
+	RuleReasonEnforcing = "Enforcing"
+
+	// RuleReasonDryRun means the rule only previews taint changes.
+	RuleReasonDryRun = "DryRun"
+
+	// RuleReasonSuspended means the rule is outside its activation windows.
+	RuleReasonSuspended = "Suspended"
+
+	// RuleReasonReconciling means the controller has not reconciled the latest spec yet.
+	RuleReasonReconciling = "Reconciling"
+
+	// RuleReasonReconciled means the controller has reconciled the latest spec.
+	RuleReasonReconciled = "Reconciled"
+
+	// RuleReasonRollingOut means the rule's rollout has not reached its last stage.
+	RuleReasonRollingOut = "RollingOut"
+
+	// RuleReasonRolloutBlocked means the rule's rollout does not advance because a threshold is exceeded.
+	RuleReasonRolloutBlocked = "RolloutBlocked"
+
+	// RuleReasonInvalidNodeSelector means the rule's nodeSelector cannot be parsed.
+	RuleReasonInvalidNodeSelector = "InvalidNodeSelector"
+
+	// RuleReasonNodeEvaluationFailed means the rule could not be evaluated for some Nodes.
+	RuleReasonNodeEvaluationFailed = "NodeEvaluationFailed"
+
+	// RuleReasonAsExpected means the rule is not degraded.
+	RuleReasonAsExpected = "AsExpected"
+)
+
+// TaintStatus specifies status of the Taint on Node.
+// +kubebuilder:validation:Enum=Present;Absent
+type TaintStatus string
+
+const (
+	// TaintStatusPresent represent the taint present on the Node.
+	TaintStatusPresent TaintStatus = "Present"
+
+	// TaintStatusAbsent represent the taint absent on the Node.
+	TaintStatusAbsent TaintStatus = "Absent"
+)
+
+// OverrideAction specifies how an operator override changes the rule's handling of a Node.
+// +kubebuilder:validation:Enum=force-hold;force-release;exempt
+type OverrideAction string
+
+const (
+	// OverrideActionForceHold keeps the rule's taint on the Node regardless of its conditions.
+	OverrideActionForceHold OverrideAction = "force-hold"
+
+	// OverrideActionForceRelease removes the rule's taint from the Node regardless of its conditions.
+	OverrideActionForceRelease OverrideAction = "force-release"
+
+	// OverrideActionExempt makes the rule leave the Node's taints untouched.
+	OverrideActionExempt OverrideAction = "exempt"
+)
+
+// DrainPhase is the progress of draining a Node.
+// +kubebuilder:validation:Enum=Draining;Blocked;Completed
+type DrainPhase string
+
+const (
+	// DrainPhaseDraining means Pods are being evicted from the Node.
+	DrainPhaseDraining DrainPhase = "Draining"
+
+	// DrainPhaseBlocked means evictions are being refused, typically by a PodDisruptionBudget.
+	DrainPhaseBlocked DrainPhase = "Blocked"
+
+	// DrainPhaseCompleted means no Pods are left to evict from the Node.
+	DrainPhaseCompleted DrainPhase = "Completed"
+)
+
+// EvaluationResult is the outcome of evaluating a rule's conditions against a Node.
+// +kubebuilder:validation:Enum=Satisfied;Unsatisfied
+type EvaluationResult string
+
+const (
+	// EvaluationResultSatisfied means the Node met the rule's conditions.
+	EvaluationResultSatisfied EvaluationResult = "Satisfied"
+
+	// EvaluationResultUnsatisfied means the Node did not meet the rule's conditions.
+	EvaluationResultUnsatisfied EvaluationResult = "Unsatisfied"
+)
+
+// Note for Developers: taintEscalation immutability validation is placed at the
+// NodeReadinessRuleSpec level as well as on the field, because taintEscalation is optional.
+// When adding or removing it, field-level transition rules are bypassed since CEL only
+// evaluates them when both self and oldSelf are present.
+
+// NodeReadinessRuleSpec defines the desired state of NodeReadinessRule.
+//
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.taintEscalation) == has(self.taintEscalation)",message="taintEscalation is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(self.deletionPolicy) && self.deletionPolicy == 'OrphanToRule') == has(self.successorRuleName)",message="successorRuleName must be set if and only if deletionPolicy is OrphanToRule"
+// +kubebuilder:validation:XValidation:rule="self.enforcementMode != 'BootstrapOnly' || self.conditionPolicy == 'AllOf'",message="conditionPolicy must be AllOf when enforcementMode is BootstrapOnly"
+// +kubebuilder:validation:XValidation:rule="self.enforcementMode != 'BootstrapOnly' || self.conditions.all(c, c.defaultStatus == 'Unknown')",message="defaultStatus must be Unknown when enforcementMode is BootstrapOnly"
+type NodeReadinessRuleSpec struct {
+	// conditions contains a list of the Node conditions that defines the specific
+	// criteria that must be met for taints to be managed on the target Node.
+	// The presence or status of these conditions directly triggers the application or removal of Node taints.
+	//
+	// +required
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="conditions is immutable"
+	Conditions []ConditionRequirement `json:"conditions"` //nolint:kubeapilinter
+
+	// enforcementMode specifies how the controller maintains the desired state.
+	// enforcementMode is one of BootstrapOnly, Continuous.
+	// "BootstrapOnly" applies the configuration once during initial setup.
+	// "Continuous" ensures the state is monitored and corrected throughout the resource lifecycle.
+	//
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="enforcementMode is immutable"
+	EnforcementMode EnforcementMode `json:"enforcementMode,omitempty"`
+
+	// taint defines the specific Taint (Key, Value, and Effect) to be managed
+	// on Nodes that meet the defined condition criteria.
+	//
+	// The taint key must follow Kubernetes qualified name format: prefix/name
+	// where prefix is 'readiness.k8s.io' (DNS subdomain) and name is a qualified
+	// name (max 63 chars, alphanumeric, '-', '_', '.', must start and end with alphanumeric).
+	// ref: git.k8s.io/kubernetes/staging/src/k8s.io/apimachinery/pkg/api/validate/content/kube.go#L24-L72
+	//
+	// Supported effects: NoSchedule, PreferNoSchedule, NoExecute.
+	// Caution: NoExecute evicts existing pods and can cause significant disruption
+	// when combined with continuous enforcement mode. Prefer NoSchedule for most use cases.
+	//
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self.key.startsWith('readiness.k8s.io/')",message="taint key must start with 'readiness.k8s.io/'"
+	// +kubebuilder:validation:XValidation:rule="self.key.size() <= 253",message="taint key length must be at most 253 characters"
+	// +kubebuilder:validation:XValidation:rule="size(self.key.split('/')) == 2",message="taint key must have exactly one '/' separator (prefix/name format)"
+	// +kubebuilder:validation:XValidation:rule="size(self.key.split('/')[1]) > 0 && size(self.key.split('/')[1]) <= 63",message="taint key name part must be 1-63 characters"
+	// +kubebuilder:validation:XValidation:rule="self.key.split('/')[1].matches('^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$')",message="taint key name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character"
+	// +kubebuilder:validation:XValidation:rule="!has(self.value) || self.value.size() <= 63",message="taint value length must be at most 63 characters"
+	// +kubebuilder:validation:XValidation:rule="self.effect in ['NoSchedule', 'PreferNoSchedule', 'NoExecute']",message="taint effect must be one of 'NoSchedule', 'PreferNoSchedule', 'NoExecute'"
+	// +kubebuilder:validation:XValidation:rule="!has(oldSelf.key) || self.key == oldSelf.key",message="taint key is immutable"
+	// +kubebuilder:validation:XValidation:rule="!has(oldSelf.effect) || self.effect == oldSelf.effect",message="taint effect is immutable"
+	// +kubebuilder:validation:XValidation:rule="!has(oldSelf.value) || self.value == oldSelf.value",message="taint value is immutable"
+	Taint corev1.Taint `json:"taint,omitempty,omitzero"`
+
+	// nodeSelector limits the scope of this rule to a specific subset of Nodes.
+	//
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="nodeSelector is immutable"
+	NodeSelector metav1.LabelSelector `json:"nodeSelector,omitempty,omitzero"`
+
+	// conditionPolicy controls how the conditions list is evaluated.
+	// conditionPolicy is one of AllOf, AnyOf.
+	// "AllOf" requires every condition to match its requiredStatus before the taint is removed.
+	// "AnyOf" requires at least one condition to match its requiredStatus.
+	//
+	// AnyOf cannot be used with enforcementMode: BootstrapOnly.
+	//
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="conditionPolicy is immutable"
+	ConditionPolicy ConditionPolicy `json:"conditionPolicy,omitempty"`
+
+	// dryRun when set to true, The controller will evaluate Node conditions and log intended taint modifications
+	// without persisting changes to the cluster. Proposed actions are reflected in the resource status.
+	//
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"` //nolint:kubeapilinter
+
+	// nodeScope restricts the rule to recently created Nodes, so that
+	// creating a rule does not taint Nodes that have been running for a long
+	// time, e.g. because they never reported a condition the rule requires.
+	// Nodes outside the scope are marked as having completed bootstrap
+	// without being evaluated. When omitted, the rule applies to all Nodes
+	// matching nodeSelector.
+	//
+	// nodeScope can only be used with enforcementMode: BootstrapOnly.
+	//
+	// +optional
+	NodeScope NodeScope `json:"nodeScope,omitempty,omitzero"`
+
+	// bootstrapRearmTriggers lists the events that make the rule bootstrap a
+	// Node again once it has completed bootstrap. When one of them happens,
+	// the bootstrap completion annotation is dropped, the taint is applied
+	// again and is removed once the conditions are met, as on the first
+	// bootstrap.
+	// Each trigger is one of NodeReboot, KubeletUpgrade, Annotation.
+	// "NodeReboot" re-arms when status.nodeInfo.bootID changes.
+	// "KubeletUpgrade" re-arms when status.nodeInfo.kubeletVersion changes.
+	// "Annotation" re-arms when the Node is annotated with
+	// readiness.k8s.io/rearm-bootstrap; the controller removes the annotation
+	// once all rules have seen it.
+	//
+	// bootstrapRearmTriggers can only be used with enforcementMode: BootstrapOnly.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=3
+	BootstrapRearmTriggers []BootstrapRearmTrigger `json:"bootstrapRearmTriggers,omitempty"`
+
+	// bootstrapID is a stable identity for the rule's bootstrap completions
+	// that survives the rule being recreated, e.g. by a backup restore or a
+	// GitOps delete-and-recreate, which gives the rule a new UID. Nodes that
+	// completed bootstrap for a previous rule with the same bootstrapID are
+	// recognised as completed instead of being tainted again, and their
+	// completion annotation is migrated to the new UID.
+	// Completion annotations of a deleted rule with a bootstrapID are kept,
+	// so that the recreated rule can adopt them, until the background sweep
+	// finds no rule claiming them.
+	// bootstrapID must be unique among rules that are not being deleted.
+	//
+	// bootstrapID can only be used with enforcementMode: BootstrapOnly.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="bootstrapID is immutable"
+	BootstrapID string `json:"bootstrapID,omitempty"`
+
+	// bootstrapAdoptionPolicy controls which bootstrap completions recorded
+	// by previous rules the rule adopts.
+	// bootstrapAdoptionPolicy is one of None, SameRuleName.
+	// "None" (default) only trusts completions recorded under the rule's own
+	// UID or, when set, its bootstrapID.
+	// "SameRuleName" also trusts completions recorded by a previous rule with
+	// the same name, which allows rules created before bootstrapID was set to
+	// be recreated safely. Completions are migrated to the new UID, and those
+	// of a deleted rule are kept until the background sweep finds no rule
+	// claiming them.
+	//
+	// bootstrapAdoptionPolicy can only be used with enforcementMode: BootstrapOnly.
+	//
+	// +optional
+	BootstrapAdoptionPolicy BootstrapAdoptionPolicy `json:"bootstrapAdoptionPolicy,omitempty"` // Use GetBootstrapAdoptionPolicy() for safe access; field may be empty even when None applies.
+
+	// flapDetection quarantines Nodes whose conditions keep flipping between
+	// satisfied and unsatisfied. A quarantined Node keeps the taint until it
+	// has been stable for the cooldown period, or until an operator clears the
+	// quarantine by annotating the Node with readiness.k8s.io/clear-quarantine.
+	//
+	// flapDetection cannot be used with enforcementMode: BootstrapOnly.
+	//
+	// +optional
+	FlapDetection FlapDetection `json:"flapDetection,omitempty,omitzero"`
+
+	// taintEscalation escalates the effect of the taint the longer a Node
+	// stays unready. The taint is first applied with the effect from taint,
+	// and its effect is swapped in place for the effect of each step once
+	// the taint has been present for that step's afterSeconds.
+	//
+	// Steps must be ordered by increasing afterSeconds and each step's effect
+	// must be more restrictive than the previous one, from PreferNoSchedule
+	// through NoSchedule to NoExecute.
+	//
+	// taintEscalation cannot be used with enforcementMode: BootstrapOnly.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="taintEscalation is immutable"
+	TaintEscalation []TaintEscalationStep `json:"taintEscalation,omitempty"`
+
+	// taintAdoptionPolicy controls what the rule does when it first evaluates
+	// a Node that already carries its taint, e.g. applied by the Node's
+	// provisioner or left behind by a previous rule.
+	// taintAdoptionPolicy is one of Adopt, Refuse, Replace.
+	// "Adopt" (default) takes over the taint as is, including its value and
+	// the time it was added.
+	// "Refuse" leaves the Node and its taints untouched, records the refusal
+	// in the Node's status and emits a Warning event. The rule manages the
+	// Node once the taint has been removed.
+	// "Replace" removes the existing taint and, unless the Node is ready,
+	// applies the rule's taint afresh in the same update.
+	//
+	// +optional
+	TaintAdoptionPolicy TaintAdoptionPolicy `json:"taintAdoptionPolicy,omitempty"` // Use GetTaintAdoptionPolicy() for safe access; field may be empty even when Adopt applies.
+
+	// nodeExitPolicy controls what happens when a Node the rule has evaluated
+	// stops matching nodeSelector, e.g. because its labels changed.
+	// nodeExitPolicy is one of RemoveTaint, KeepTaint.
+	// "RemoveTaint" (default) removes the rule's taint from the Node, unless
+	// another rule matching the Node manages the same taint, and drops the
+	// Node from the rule's status.
+	// "KeepTaint" leaves the taint and the Node's status entry in place.
+	//
+	// +optional
+	NodeExitPolicy NodeExitPolicy `json:"nodeExitPolicy,omitempty"` // Use GetNodeExitPolicy() for safe access; field may be empty even when RemoveTaint applies.
+
+	// deletionPolicy controls what happens to the rule's taint when the rule
+	// is deleted.
+	// deletionPolicy is one of Delete, Retain, OrphanToRule.
+	// "Delete" (default) removes the taint from every Node the rule selects.
+	// "Retain" leaves the taint on every Node and only removes the finalizer.
+	// "OrphanToRule" hands the taint over to the rule named by
+	// successorRuleName, which must manage the same taint key and effect.
+	// Deletion waits until the successor exists; the taint is then left on
+	// the Nodes the successor selects and removed from all others.
+	//
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"` // Use GetDeletionPolicy() for safe access; field may be empty even when Delete applies.
+
+	// successorRuleName is the name of the rule that takes over the taint
+	// when deletionPolicy is OrphanToRule. It must be set if and only if
+	// deletionPolicy is OrphanToRule.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	SuccessorRuleName string `json:"successorRuleName,omitempty"`
+
+	// rollout enforces the rule progressively, on a growing fraction of the
+	// Nodes matching nodeSelector. Nodes are picked by a stable hash of the
+	// rule and Node names, so that each stage enforces the rule on a superset
+	// of the Nodes of the previous one. The remaining Nodes are accounted for
+	// in dryRunResults. When omitted, the rule is enforced on all Nodes.
+	//
+	// rollout cannot be used with enforcementMode: BootstrapOnly.
+	//
+	// +optional
+	Rollout Rollout `json:"rollout,omitempty,omitzero"`
+
+	// schedule limits when the rule is in effect, e.g. to the duration of a
+	// maintenance campaign. Outside its activation windows the rule is
+	// suspended: Nodes are not evaluated and taints are neither added nor
+	// removed. Once expiresAt has passed, the rule is deleted and its taints
+	// are cleaned up according to deletionPolicy.
+	//
+	// +optional
+	Schedule RuleSchedule `json:"schedule,omitempty,omitzero"`
+
+	// drain evicts Pods that do not tolerate the taint from Nodes that stay
+	// unready, through the Eviction API so that PodDisruptionBudgets are
+	// respected. Unlike a NoExecute taint, evictions that would violate a
+	// PodDisruptionBudget are retried later instead of being forced.
+	// DaemonSet and mirror Pods are never evicted.
+	//
+	// drain cannot be used with enforcementMode: BootstrapOnly.
+	//
+	// +optional
+	Drain Drain `json:"drain,omitempty,omitzero"`
+}
+
+// NodeScope selects the Nodes a bootstrap-only rule applies to by their age.
+// Nodes are compared against the rule's creationTimestamp, so whether a Node
+// is in scope does not change over time.
+// +kubebuilder:validation:XValidation:rule="self.type == 'MaxAge' ? has(self.maxAgeSeconds) : !has(self.maxAgeSeconds)",message="maxAgeSeconds must be set if and only if type is MaxAge"
+type NodeScope struct {
+	// type selects the Nodes the rule applies to, one of CreatedAfterRule, MaxAge.
+	// "CreatedAfterRule" applies the rule to Nodes created after the rule.
+	// "MaxAge" applies the rule to Nodes that were created at most
+	// maxAgeSeconds before the rule.
+	//
+	// +required
+	Type NodeScopeType `json:"type,omitempty"`
+
+	// maxAgeSeconds is how old, in seconds, a Node may have been when the
+	// rule was created for the rule to apply to it. It must be set if and only
+	// if type is MaxAge.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=31536000
+	MaxAgeSeconds int32 `json:"maxAgeSeconds,omitempty"`
+}
+
+// Rollout configures the progressive enforcement of a rule.
+type Rollout struct {
+	// stages are the steps of the rollout, in order. Each stage enforces the
+	// rule on a percentage of the matching Nodes for at least pauseSeconds,
+	// after which the rollout advances to the next stage unless a threshold
+	// is exceeded. The rule stays at the last stage.
+	//
+	// Stages must be ordered by increasing percent, and the last stage must
+	// enforce the rule on all Nodes, with a percent of 100.
+	//
+	// +required
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	Stages []RolloutStage `json:"stages,omitempty"`
+
+	// maxFailurePercent is the highest percentage of the Nodes the rule is
+	// enforced on that may be failing evaluation for the rollout to
+	// advance. When not set, failures do not block it.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxFailurePercent *int32 `json:"maxFailurePercent,omitempty"`
+
+	// maxNewlyTaintedNodes is the highest number of Nodes that may have been
+	// tainted by the rule during the current stage for the rollout to
+	// advance. When not set, newly tainted Nodes do not block it.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100000
+	MaxNewlyTaintedNodes *int32 `json:"maxNewlyTaintedNodes,omitempty"`
+}
+
+// RolloutStage is a step of a rule's rollout.
+type RolloutStage struct {
+	// percent is the percentage of the matching Nodes the rule is enforced on.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Percent int32 `json:"percent,omitempty"`
+
+	// pauseSeconds is how long, in seconds, the stage lasts at least before
+	// the rollout advances to the next one.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=604800
+	PauseSeconds int32 `json:"pauseSeconds,omitempty"`
+}
+
+// RuleSchedule limits when a rule is in effect.
+// +kubebuilder:validation:MinProperties=1
+type RuleSchedule struct {
+	// activationWindows are the recurring periods during which the rule is
+	// active. The rule is active while any of the windows is open. When
+	// omitted, the rule is active until it expires.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	ActivationWindows []ActivationWindow `json:"activationWindows,omitempty"`
+
+	// timeZone is the IANA name of the time zone the activation windows are
+	// interpreted in, e.g. Europe/Berlin. Defaults to UTC when not set.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// expiresAt is the time after which the rule is deleted.
+	//
+	// +optional
+	ExpiresAt metav1.Time `json:"expiresAt,omitempty,omitzero"`
+}
+
+// ActivationWindow is a recurring period during which a rule is active.
+type ActivationWindow struct {
+	// start is a cron expression in the standard five-field format, or a
+	// descriptor such as @daily, at which the window opens.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Start string `json:"start,omitempty"`
+
+	// durationSeconds is how long, in seconds, the window stays open.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=604800
+	DurationSeconds int32 `json:"durationSeconds,omitempty"`
+}
+
+// Drain configures how the controller drains Nodes that stay unready.
+type Drain struct {
+	// afterSeconds is how long, in seconds, the taint must have been present
+	// on the Node before its Pods are evicted.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=604800
+	AfterSeconds int32 `json:"afterSeconds,omitempty"`
+
+	// maxEvictionsPerMinute limits how many Pods the rule evicts per minute
+	// across all Nodes. Defaults to 10 when not set.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	MaxEvictionsPerMinute int32 `json:"maxEvictionsPerMinute,omitempty"`
+}
+
+// TaintEscalationStep is a step of the taint effect escalation ladder.
+type TaintEscalationStep struct {
+	// effect is the taint effect applied once this step is reached.
+	//
+	// +required
+	// +kubebuilder:validation:Enum=PreferNoSchedule;NoSchedule;NoExecute
+	Effect corev1.TaintEffect `json:"effect,omitempty"`
+
+	// afterSeconds is how long, in seconds, the taint must have been present
+	// on the Node before this step is reached.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=604800
+	AfterSeconds int32 `json:"afterSeconds,omitempty"`
+}
+
+// FlapDetection configures how the controller detects and quarantines flapping Nodes.
+type FlapDetection struct {
+	// transitionThreshold is the number of transitions between satisfied and
+	// unsatisfied within windowSeconds after which a Node is quarantined.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=1000
+	TransitionThreshold int32 `json:"transitionThreshold,omitempty"`
+
+	// windowSeconds is the length of the window, in seconds, over which transitions are counted.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	WindowSeconds int32 `json:"windowSeconds,omitempty"`
+
+	// cooldownSeconds is how long, in seconds, a quarantined Node must go
+	// without a transition before the quarantine is lifted.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=604800
+	CooldownSeconds int32 `json:"cooldownSeconds,omitempty"`
+}
+
+// ConditionRequirement defines a specific Node condition and the status value
+// required to trigger the controller's action, and the status the condition is
+// evaluated to when the Node does not report it.
+type ConditionRequirement struct {
+	// type of Node condition
+	//
+	// Following kubebuilder validation is referred from https://pkg.go.dev/k8s.io/apimachinery/pkg/apis/meta/v1#Condition
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	Type string `json:"type,omitempty"`
+
+	// requiredStatus is status of the condition, one of True, False, Unknown.
+	//
+	// +required
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	RequiredStatus corev1.ConditionStatus `json:"requiredStatus,omitempty"`
+
+	// defaultStatus is the status a condition is evaluated to if the condition
+	// is not found in a node, one of True, False, Unknown.
+	//
+	// defaultStatus must be Unknown when enforcementMode is BootstrapOnly.
+	//
+	// +required
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	DefaultStatus corev1.ConditionStatus `json:"defaultStatus,omitempty"`
+}
+
+// NodeReadinessRuleStatus defines the observed state of NodeReadinessRule.
+// +kubebuilder:validation:MinProperties=1
+type NodeReadinessRuleStatus struct {
+	// conditions represent the current state of the rule. The Ready condition
+	// is true when the controller has reconciled the latest spec and the rule
+	// is not degraded; Progressing while the latest spec is being reconciled
+	// or the rule is being rolled out; and Degraded when the rule's
+	// nodeSelector is invalid, some Nodes failed evaluation, or the rollout
+	// is blocked.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// observedGeneration reflects the generation of the most recently observed NodeReadinessRule by the controller.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// appliedNodes lists the names of Nodes where the taint has been successfully managed.
+	// This provides a quick reference to the scope of impact for this rule.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=5000
+	// +kubebuilder:validation:items:MaxLength=253
+	AppliedNodes []string `json:"appliedNodes,omitempty"`
+
+	// failedNodes lists the Nodes where the rule evaluation encountered an error.
+	// This is used for troubleshooting configuration issues, such as invalid selectors during node lookup.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=nodeName
+	// +kubebuilder:validation:MaxItems=5000
+	FailedNodes []NodeFailure `json:"failedNodes,omitempty"`
+
+	// nodeEvaluations provides detailed insight into the rule's assessment for individual Nodes.
+	// This is primarily used for auditing and debugging why specific Nodes were or
+	// were not targeted by the rule.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=nodeName
+	// +kubebuilder:validation:MaxItems=5000
+	NodeEvaluations []NodeEvaluation `json:"nodeEvaluations,omitempty"`
+
+	// nodeCounts summarizes the state of the Nodes the rule manages. Unlike
+	// nodeEvaluations, it is not limited in the number of Nodes it covers.
+	//
+	// +optional
+	NodeCounts RuleNodeCounts `json:"nodeCounts,omitempty,omitzero"`
+
+	// rollout reports the progress of the rule's rollout. It is omitted when
+	// the rule has no rollout.
+	//
+	// +optional
+	Rollout RolloutStatus `json:"rollout,omitempty,omitzero"`
+
+	// scheduleState reports whether a rule with a schedule is currently
+	// active, one of Active, Inactive. It is omitted when the rule has no
+	// schedule.
+	//
+	// +optional
+	ScheduleState ScheduleState `json:"scheduleState,omitempty"`
+
+	// nextTransitionTime is when the rule next becomes active or inactive, or
+	// expires. It is omitted when the rule has no schedule.
+	//
+	// +optional
+	NextTransitionTime metav1.Time `json:"nextTransitionTime,omitempty,omitzero"`
+
+	// dryRunResults captures the outcome of the rule evaluation when DryRun is enabled.
+	// This field provides visibility into the actions the controller would have taken,
+	// allowing users to preview taint changes before they are committed.
+	//
+	// +optional
+	DryRunResults DryRunResults `json:"dryRunResults,omitempty,omitzero"`
+
+	// dryRunStartTime is when the controller started evaluating the rule's
+	// current spec in dry run mode. It is reset when the spec changes, and
+	// omitted when the rule is not in dry run mode.
+	//
+	// +optional
+	DryRunStartTime metav1.Time `json:"dryRunStartTime,omitempty,omitzero"`
+}
+
+// RolloutStatus reports the progress of a rule's rollout.
+type RolloutStatus struct {
+	// currentStage is the 1-based index of the stage in effect.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	CurrentStage int32 `json:"currentStage,omitempty"`
+
+	// percent is the percentage of the matching Nodes the rule is enforced on.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Percent int32 `json:"percent,omitempty"`
+
+	// phase is the progress of the rollout, one of Progressing, Blocked, Completed.
+	//
+	// +required
+	Phase RolloutPhase `json:"phase,omitempty"`
+
+	// stageStartTime is when the current stage started.
+	//
+	// +required
+	StageStartTime metav1.Time `json:"stageStartTime,omitempty,omitzero"`
+
+	// enforcedNodes is the number of matching Nodes the rule is enforced on.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	EnforcedNodes *int32 `json:"enforcedNodes,omitempty"`
+
+	// failingNodes is the number of Nodes the rule is enforced on that are
+	// failing evaluation.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	FailingNodes *int32 `json:"failingNodes,omitempty"`
+
+	// newlyTaintedNodes is the number of Nodes tainted by the rule since the
+	// current stage started, including Nodes whose taint was removed since.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	NewlyTaintedNodes *int32 `json:"newlyTaintedNodes,omitempty"`
+
+	// message explains why the rollout is blocked.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
+}
+
+// NodeFailure provides diagnostic details for Nodes that could not be successfully evaluated by the rule.
+type NodeFailure struct {
+	// nodeName is the name of the failed Node.
+	//
+	// Following kubebuilder validation is referred from
+	// https://github.com/kubernetes/apimachinery/blob/84d740c9e27f3ccc94c8bc4d13f1b17f60f7080b/pkg/util/validation/validation.go#L198
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	NodeName string `json:"nodeName,omitempty"`
+
+	// reason provides a brief explanation of the evaluation result.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Reason string `json:"reason,omitempty"`
+
+	// message is a human-readable message indicating details about the evaluation.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=10240
+	Message string `json:"message,omitempty"`
+
+	// lastEvaluationTime is the timestamp of the last rule check failed for this Node.
+	//
+	// +required
+	LastEvaluationTime metav1.Time `json:"lastEvaluationTime,omitempty,omitzero"`
+}
+
+// NodeEvaluation provides a detailed audit of a single Node's compliance with the rule.
+type NodeEvaluation struct {
+	// nodeName is the name of the evaluated Node.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	NodeName string `json:"nodeName,omitempty"`
+
+	// conditionResults provides a detailed breakdown of each condition evaluation
+	// for this Node. This allows for granular auditing of which specific
+	// criteria passed or failed during the rule assessment.
+	//
+	// +required
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=5000
+	ConditionResults []ConditionEvaluationResult `json:"conditionResults,omitempty"`
+
+	// taintStatus represents the taint status on the Node, one of Present, Absent.
+	//
+	// +required
+	TaintStatus TaintStatus `json:"taintStatus,omitempty"`
+
+	// lastEvaluationTime is the timestamp when the controller last assessed this Node.
+	//
+	// +required
+	LastEvaluationTime metav1.Time `json:"lastEvaluationTime,omitempty,omitzero"`
+
+	// override reports the operator override that was in effect for this Node
+	// during the last evaluation. It is omitted when no override applies.
+	//
+	// +optional
+	Override NodeOverride `json:"override,omitempty,omitzero"`
+
+	// flap tracks condition transitions for flap detection. It is only
+	// populated when the rule has flapDetection configured.
+	//
+	// +optional
+	Flap FlapState `json:"flap,omitempty,omitzero"`
+
+	// drain reports the progress of draining the Node. It is only populated
+	// when the rule has drain configured and the Node is being drained.
+	//
+	// +optional
+	Drain NodeDrainStatus `json:"drain,omitempty,omitzero"`
+
+	// taintAdoption records what the rule did with the taint the Node already
+	// carried when the rule first evaluated it, one of Adopted, Refused,
+	// Replaced. It is omitted when the Node did not carry the taint.
+	//
+	// +optional
+	TaintAdoption TaintAdoption `json:"taintAdoption,omitempty"`
+
+	// taintAddedTime is when the rule last added its taint to the Node. It
+	// is kept once the taint is removed. It is omitted when the rule never
+	// added its taint to the Node.
+	//
+	// +optional
+	TaintAddedTime metav1.Time `json:"taintAddedTime,omitempty,omitzero"`
+
+	// escalationStartTime is when the rule's taint started its escalation
+	// ladder on the Node. It is only recorded once the taint was escalated
+	// to NoExecute, whose TimeAdded is then reset so that pods tolerating the
+	// taint for a bounded time are evicted after their toleration; until
+	// then the ladder starts at the taint's TimeAdded.
+	//
+	// +optional
+	EscalationStartTime metav1.Time `json:"escalationStartTime,omitempty,omitzero"`
+
+	// transitions records the most recent changes of the rule's evaluation of
+	// the Node, oldest first, when the per-node detail is recorded in the
+	// rule's status. Once full, the oldest transitions are dropped. With
+	// NodeReadinessStatus objects, they are recorded in those instead.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=5
+	Transitions []NodeTransition `json:"transitions,omitempty"`
+}
+
+// NodeTransitionType is the kind of change a NodeTransition records.
+// +kubebuilder:validation:Enum=TaintAdded;TaintRemoved;ConditionChanged;EvaluationFailed;EvaluationRecovered
+type NodeTransitionType string
+
+const (
+	// NodeTransitionTaintAdded records the rule's taint being added to, or
+	// first held on, the Node.
+	NodeTransitionTaintAdded NodeTransitionType = "TaintAdded"
+
+	// NodeTransitionTaintRemoved records the rule's taint being removed from the Node.
+	NodeTransitionTaintRemoved NodeTransitionType = "TaintRemoved"
+
+	// NodeTransitionConditionChanged records a change of the status of one of
+	// the rule's conditions on the Node.
+	NodeTransitionConditionChanged NodeTransitionType = "ConditionChanged"
+
+	// NodeTransitionEvaluationFailed records the rule failing to evaluate the Node.
+	NodeTransitionEvaluationFailed NodeTransitionType = "EvaluationFailed"
+
+	// NodeTransitionEvaluationRecovered records the rule evaluating the Node
+	// again after failing to.
+	NodeTransitionEvaluationRecovered NodeTransitionType = "EvaluationRecovered"
+)
+
+// NodeTransition is a change of a rule's evaluation of a Node.
+type NodeTransition struct {
+	// type is the kind of change, one of TaintAdded, TaintRemoved,
+	// ConditionChanged, EvaluationFailed, EvaluationRecovered.
+	//
+	// +required
+	Type NodeTransitionType `json:"type,omitempty"`
+
+	// time is when the controller observed the change.
+	//
+	// +required
+	Time metav1.Time `json:"time,omitempty,omitzero"`
+
+	// conditionType is the Node condition whose status changed. It is only
+	// set for ConditionChanged transitions.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	ConditionType string `json:"conditionType,omitempty"`
+
+	// conditionStatus is the new status of the condition, one of True, False,
+	// Unknown. It is only set for ConditionChanged transitions.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	ConditionStatus corev1.ConditionStatus `json:"conditionStatus,omitempty"`
+
+	// reason is a brief, machine-readable explanation of the change.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Reason string `json:"reason,omitempty"`
+
+	// message is a human-readable description of the change.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
+}
+
+// NodeDrainStatus reports the progress of draining a Node.
+// +kubebuilder:validation:MinProperties=1
+type NodeDrainStatus struct {
+	// phase is the progress of the drain, one of Draining, Blocked, Completed.
+	//
+	// +required
+	Phase DrainPhase `json:"phase,omitempty"`
+
+	// startTime is the time the drain started.
+	//
+	// +required
+	StartTime metav1.Time `json:"startTime,omitempty,omitzero"`
+
+	// podsEvicted is the number of Pods evicted from the Node since the drain started.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	PodsEvicted *int32 `json:"podsEvicted,omitempty"`
+
+	// podsRemaining is the number of Pods still to be evicted from the Node.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	PodsRemaining *int32 `json:"podsRemaining,omitempty"`
+
+	// message is a human-readable explanation of the drain's phase.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
+}
+
+// FlapState records the transitions observed for a Node and whether it is quarantined.
+// +kubebuilder:validation:MinProperties=1
+type FlapState struct {
+	// lastResult is the outcome of the most recent evaluation, one of Satisfied, Unsatisfied.
+	//
+	// +required
+	LastResult EvaluationResult `json:"lastResult,omitempty"`
+
+	// transitions is the number of transitions observed since windowStartTime.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Transitions *int32 `json:"transitions,omitempty"`
+
+	// windowStartTime is the start of the current transition counting window.
+	//
+	// +required
+	WindowStartTime metav1.Time `json:"windowStartTime,omitempty,omitzero"`
+
+	// lastTransitionTime is the time of the most recent transition.
+	//
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty,omitzero"`
+
+	// quarantineStartTime is the time the Node was quarantined. It is omitted
+	// when the Node is not quarantined.
+	//
+	// +optional
+	QuarantineStartTime metav1.Time `json:"quarantineStartTime,omitempty,omitzero"`
+}
+
+// NodeOverride describes an operator override set on a Node through annotations.
+// +kubebuilder:validation:MinProperties=1
+type NodeOverride struct {
+	// action is the override applied to the Node, one of force-hold, force-release, exempt.
+	//
+	// +required
+	Action OverrideAction `json:"action,omitempty"`
+
+	// annotation is the Node annotation key the override was read from.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	Annotation string `json:"annotation,omitempty"`
+
+	// expirationTime is the time after which the override is no longer honoured.
+	// It is omitted when the override does not expire.
+	//
+	// +optional
+	ExpirationTime metav1.Time `json:"expirationTime,omitempty,omitzero"`
+}
+
+// ConditionEvaluationResult provides a detailed report of the comparison between
+// the Node's observed condition and the rule's requirement.
+type ConditionEvaluationResult struct {
+	// type corresponds to the Node condition type being evaluated.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	Type string `json:"type,omitempty"`
+
+	// currentStatus is the actual status value observed on the Node, one of True, False, Unknown.
+	//
+	// +required
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	CurrentStatus corev1.ConditionStatus `json:"currentStatus,omitempty"`
+
+	// requiredStatus is the status value defined in the rule that must be matched, one of True, False, Unknown.
+	//
+	// +required
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	RequiredStatus corev1.ConditionStatus `json:"requiredStatus,omitempty"`
+
+	// defaultStatus is the status a condition is evaluated to if the condition
+	// is not found in a node. Reflects the defaultStatus configured in the rule
+	// spec.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	DefaultStatus corev1.ConditionStatus `json:"defaultStatus,omitempty"`
+}
+
+// RuleNodeCounts summarizes the state of the Nodes a rule manages.
+// +kubebuilder:validation:MinProperties=1
+type RuleNodeCounts struct {
+	// matched is the number of Nodes matching the rule's nodeSelector that
+	// the rule has evaluated.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Matched *int32 `json:"matched,omitempty"`
+
+	// held is the number of matched Nodes the rule's taint is held on.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Held *int32 `json:"held,omitempty"`
+
+	// released is the number of matched Nodes the rule's taint is not on.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Released *int32 `json:"released,omitempty"`
+
+	// failed is the number of Nodes the rule failed to evaluate.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Failed *int32 `json:"failed,omitempty"`
+
+	// bootstrapCompleted is the number of matched Nodes that completed
+	// bootstrap. It is only set for bootstrap-only rules.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BootstrapCompleted *int32 `json:"bootstrapCompleted,omitempty"`
+}
+
+// DryRunResults provides a summary of the actions the controller would perform if DryRun mode is enabled.
+// +kubebuilder:validation:MinProperties=1
+type DryRunResults struct {
+	// affectedNodes is the total count of Nodes that match the rule's criteria.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	AffectedNodes *int32 `json:"affectedNodes,omitempty"`
+
+	// taintsToAdd is the number of Nodes that currently lack the specified taint and would have it applied.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TaintsToAdd *int32 `json:"taintsToAdd,omitempty"`
+
+	// taintsToRemove is the number of Nodes that currently possess the
+	// taint but no longer meet the criteria, leading to its removal.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TaintsToRemove *int32 `json:"taintsToRemove,omitempty"`
+
+	// taintsToEscalate is the number of Nodes whose taint is due for the next
+	// step of the taint escalation ladder and would have its effect changed.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TaintsToEscalate *int32 `json:"taintsToEscalate,omitempty"`
+
+	// outOfScopeNodes is the number of Nodes that match nodeSelector but fall
+	// outside nodeScope. They are not counted in affectedNodes and would be
+	// marked as having completed bootstrap without being tainted.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	OutOfScopeNodes *int32 `json:"outOfScopeNodes,omitempty"`
+
+	// riskyOperations represents the count of Nodes where required conditions
+	// are missing entirely, potentially indicating an ambiguous node state.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RiskyOperations *int32 `json:"riskyOperations,omitempty"`
+
+	// summary provides a human-readable overview of the dry run evaluation,
+	// highlighting key findings or warnings.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Summary string `json:"summary,omitempty"`
+
+	// nodes lists the Nodes the rule would change, or whose required
+	// conditions are missing, by name. Only the first 100 are listed; the
+	// controller's dry run endpoint serves the full list.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=nodeName
+	// +kubebuilder:validation:MaxItems=100
+	Nodes []DryRunNode `json:"nodes,omitempty"`
+}
+
+// DryRunAction is the change a rule in dry run mode would make to a Node.
+// +kubebuilder:validation:Enum=AddTaint;RemoveTaint;AdoptTaint;EscalateTaint;RiskyMissingCondition
+type DryRunAction string
+
+const (
+	// DryRunActionAddTaint means the rule would add its taint to the Node.
+	DryRunActionAddTaint DryRunAction = "AddTaint"
+
+	// DryRunActionRemoveTaint means the rule would remove its taint from the Node.
+	DryRunActionRemoveTaint DryRunAction = "RemoveTaint"
+
+	// DryRunActionAdoptTaint means the rule would take over the taint the Node
+	// already carries, according to its taintAdoptionPolicy.
+	DryRunActionAdoptTaint DryRunAction = "AdoptTaint"
+
+	// DryRunActionEscalateTaint means the rule would change the effect of its
+	// taint on the Node to the next step of its escalation ladder.
+	DryRunActionEscalateTaint DryRunAction = "EscalateTaint"
+
+	// DryRunActionRiskyMissingCondition means required conditions are missing
+	// from the Node, so the rule would act on their defaultStatus.
+	DryRunActionRiskyMissingCondition DryRunAction = "RiskyMissingCondition"
+)
+
+// DryRunNode is the change a rule in dry run mode would make to a Node.
+type DryRunNode struct {
+	// nodeName is the name of the Node.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	NodeName string `json:"nodeName,omitempty"`
+
+	// action is the change the rule would make, one of AddTaint, RemoveTaint,
+	// AdoptTaint, EscalateTaint, RiskyMissingCondition. Missing conditions take
+	// precedence over the taint change they lead to.
+	//
+	// +required
+	Action DryRunAction `json:"action,omitempty"`
+
+	// failingConditions lists the rule's conditions whose status on the Node
+	// does not match the required status.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MaxLength=316
+	FailingConditions []string `json:"failingConditions,omitempty"`
+
+	// missingConditions lists the rule's conditions the Node does not report.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MaxLength=316
+	MissingConditions []string `json:"missingConditions,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+// +kubebuilder:resource:scope=Cluster,shortName=nrr
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.enforcementMode`,description="The enforcement mode of the rule: BootstrapOnly or Continuous."
+// +kubebuilder:selectablefield:JSONPath=`.spec.enforcementMode`
+// +kubebuilder:printcolumn:name="Taint",type=string,JSONPath=`.spec.taint.key`,description="The readiness taint applied by this rule."
+// +kubebuilder:selectablefield:JSONPath=`.spec.taint.key`
+// +kubebuilder:printcolumn:name="Effect",type=string,JSONPath=`.spec.taint.effect`,description="The taint effect: NoSchedule, PreferNoSchedule or NoExecute."
+// +kubebuilder:printcolumn:name="DryRun",type=boolean,JSONPath=`.spec.dryRun`,description="Whether the rule is in dry-run mode and only previews taint changes."
+// +kubebuilder:selectablefield:JSONPath=`.spec.dryRun`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Whether the latest spec of the rule is reconciled and the rule is not degraded."
+// +kubebuilder:printcolumn:name="Progressing",type=string,JSONPath=`.status.conditions[?(@.type=="Progressing")].status`,description="Whether the rule is being reconciled or rolled out."
+// +kubebuilder:printcolumn:name="Degraded",type=string,JSONPath=`.status.conditions[?(@.type=="Degraded")].status`,description="Whether some Nodes failed evaluation or the rollout is blocked."
+// +kubebuilder:printcolumn:name="Matched",type=integer,JSONPath=`.status.nodeCounts.matched`,description="The number of Nodes matching the rule that it has evaluated."
+// +kubebuilder:printcolumn:name="Held",type=integer,JSONPath=`.status.nodeCounts.held`,description="The number of Nodes the rule's taint is held on."
+// +kubebuilder:printcolumn:name="Released",type=integer,JSONPath=`.status.nodeCounts.released`,description="The number of Nodes the rule's taint is not on.",priority=1
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.nodeCounts.failed`,description="The number of Nodes the rule failed to evaluate."
+// +kubebuilder:printcolumn:name="Completed",type=integer,JSONPath=`.status.nodeCounts.bootstrapCompleted`,description="The number of Nodes that completed bootstrap.",priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="The age of this resource"
+
+// NodeReadinessRule is the Schema for the NodeReadinessRules API.
+type NodeReadinessRule struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	//
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of NodeReadinessRule
+	//
+	// +required
+	Spec NodeReadinessRuleSpec `json:"spec,omitempty,omitzero"`
+
+	// status defines the observed state of NodeReadinessRule
+	//
+	// +optional
+	Status NodeReadinessRuleStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeReadinessRuleList contains a list of NodeReadinessRule.
+type NodeReadinessRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard list's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds
+	//
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// items is the list of NodeReadinessRule.
+	Items []NodeReadinessRule `json:"items"`
+}
+
+// GetTaintEffects returns every effect the rule's taint can have on a Node:
+// the effect from taint followed by the effects of the escalation ladder.
+func (spec *NodeReadinessRuleSpec) GetTaintEffects() []corev1.TaintEffect {
+	effects := []corev1.TaintEffect{spec.Taint.Effect}
+	for _, step := range spec.TaintEscalation {
+		effects = append(effects, step.Effect)
+	}
+	return effects
+}
+
+// GetNodeExitPolicy returns the effective node exit policy, defaulting to
+// RemoveTaint when the field is not explicitly set.
+func (spec *NodeReadinessRuleSpec) GetNodeExitPolicy() NodeExitPolicy {
+	if spec.NodeExitPolicy == "" {
+		return NodeExitPolicyRemoveTaint
+	}
+	return spec.NodeExitPolicy
+}
+
+// GetTaintAdoptionPolicy returns the effective taint adoption policy,
+// defaulting to Adopt when the field is not explicitly set.
+func (spec *NodeReadinessRuleSpec) GetTaintAdoptionPolicy() TaintAdoptionPolicy {
+	if spec.TaintAdoptionPolicy == "" {
+		return TaintAdoptionPolicyAdopt
+	}
+	return spec.TaintAdoptionPolicy
+}
+
+// GetDeletionPolicy returns the effective deletion policy, defaulting to
+// Delete when the field is not explicitly set.
+func (spec *NodeReadinessRuleSpec) GetDeletionPolicy() DeletionPolicy {
+	if spec.DeletionPolicy == "" {
+		return DeletionPolicyDelete
+	}
+	return spec.DeletionPolicy
+}
+
+// GetBootstrapAdoptionPolicy returns the effective bootstrap adoption policy,
+// defaulting to None when the field is not explicitly set.
+func (spec *NodeReadinessRuleSpec) GetBootstrapAdoptionPolicy() BootstrapAdoptionPolicy {
+	if spec.BootstrapAdoptionPolicy == "" {
+		return BootstrapAdoptionPolicyNone
+	}
+	return spec.BootstrapAdoptionPolicy
+}
+
+// GetMaxEvictionsPerMinute returns the effective eviction rate limit,
+// defaulting to 10 when the field is not explicitly set.
+func (d *Drain) GetMaxEvictionsPerMinute() int32 {
+	if d.MaxEvictionsPerMinute == 0 {
+		return 10
+	}
+	return d.MaxEvictionsPerMinute
+}
+
+func init() {
+	objectTypes = append(objectTypes, &NodeReadinessRule{}, &NodeReadinessRuleList{})
+}
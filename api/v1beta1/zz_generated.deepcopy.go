@@ -0,0 +1,617 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivationWindow) DeepCopyInto(out *ActivationWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivationWindow.
+func (in *ActivationWindow) DeepCopy() *ActivationWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivationWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionEvaluationResult) DeepCopyInto(out *ConditionEvaluationResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionEvaluationResult.
+func (in *ConditionEvaluationResult) DeepCopy() *ConditionEvaluationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionEvaluationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionRequirement) DeepCopyInto(out *ConditionRequirement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionRequirement.
+func (in *ConditionRequirement) DeepCopy() *ConditionRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Drain) DeepCopyInto(out *Drain) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Drain.
+func (in *Drain) DeepCopy() *Drain {
+	if in == nil {
+		return nil
+	}
+	out := new(Drain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunNode) DeepCopyInto(out *DryRunNode) {
+	*out = *in
+	if in.FailingConditions != nil {
+		in, out := &in.FailingConditions, &out.FailingConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MissingConditions != nil {
+		in, out := &in.MissingConditions, &out.MissingConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunNode.
+func (in *DryRunNode) DeepCopy() *DryRunNode {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunResults) DeepCopyInto(out *DryRunResults) {
+	*out = *in
+	if in.AffectedNodes != nil {
+		in, out := &in.AffectedNodes, &out.AffectedNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TaintsToAdd != nil {
+		in, out := &in.TaintsToAdd, &out.TaintsToAdd
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TaintsToRemove != nil {
+		in, out := &in.TaintsToRemove, &out.TaintsToRemove
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TaintsToEscalate != nil {
+		in, out := &in.TaintsToEscalate, &out.TaintsToEscalate
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OutOfScopeNodes != nil {
+		in, out := &in.OutOfScopeNodes, &out.OutOfScopeNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RiskyOperations != nil {
+		in, out := &in.RiskyOperations, &out.RiskyOperations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]DryRunNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunResults.
+func (in *DryRunResults) DeepCopy() *DryRunResults {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunResults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlapDetection) DeepCopyInto(out *FlapDetection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlapDetection.
+func (in *FlapDetection) DeepCopy() *FlapDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(FlapDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlapState) DeepCopyInto(out *FlapState) {
+	*out = *in
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = new(int32)
+		**out = **in
+	}
+	in.WindowStartTime.DeepCopyInto(&out.WindowStartTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	in.QuarantineStartTime.DeepCopyInto(&out.QuarantineStartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlapState.
+func (in *FlapState) DeepCopy() *FlapState {
+	if in == nil {
+		return nil
+	}
+	out := new(FlapState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeDrainStatus) DeepCopyInto(out *NodeDrainStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.PodsEvicted != nil {
+		in, out := &in.PodsEvicted, &out.PodsEvicted
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PodsRemaining != nil {
+		in, out := &in.PodsRemaining, &out.PodsRemaining
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeDrainStatus.
+func (in *NodeDrainStatus) DeepCopy() *NodeDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeEvaluation) DeepCopyInto(out *NodeEvaluation) {
+	*out = *in
+	if in.ConditionResults != nil {
+		in, out := &in.ConditionResults, &out.ConditionResults
+		*out = make([]ConditionEvaluationResult, len(*in))
+		copy(*out, *in)
+	}
+	in.LastEvaluationTime.DeepCopyInto(&out.LastEvaluationTime)
+	in.Override.DeepCopyInto(&out.Override)
+	in.Flap.DeepCopyInto(&out.Flap)
+	in.Drain.DeepCopyInto(&out.Drain)
+	in.TaintAddedTime.DeepCopyInto(&out.TaintAddedTime)
+	in.EscalationStartTime.DeepCopyInto(&out.EscalationStartTime)
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]NodeTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeEvaluation.
+func (in *NodeEvaluation) DeepCopy() *NodeEvaluation {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeEvaluation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeFailure) DeepCopyInto(out *NodeFailure) {
+	*out = *in
+	in.LastEvaluationTime.DeepCopyInto(&out.LastEvaluationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeFailure.
+func (in *NodeFailure) DeepCopy() *NodeFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeOverride) DeepCopyInto(out *NodeOverride) {
+	*out = *in
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeOverride.
+func (in *NodeOverride) DeepCopy() *NodeOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReadinessRule) DeepCopyInto(out *NodeReadinessRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessRule.
+func (in *NodeReadinessRule) DeepCopy() *NodeReadinessRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReadinessRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeReadinessRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReadinessRuleList) DeepCopyInto(out *NodeReadinessRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeReadinessRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessRuleList.
+func (in *NodeReadinessRuleList) DeepCopy() *NodeReadinessRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReadinessRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeReadinessRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReadinessRuleSpec) DeepCopyInto(out *NodeReadinessRuleSpec) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ConditionRequirement, len(*in))
+		copy(*out, *in)
+	}
+	in.Taint.DeepCopyInto(&out.Taint)
+	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+	out.NodeScope = in.NodeScope
+	if in.BootstrapRearmTriggers != nil {
+		in, out := &in.BootstrapRearmTriggers, &out.BootstrapRearmTriggers
+		*out = make([]BootstrapRearmTrigger, len(*in))
+		copy(*out, *in)
+	}
+	out.FlapDetection = in.FlapDetection
+	if in.TaintEscalation != nil {
+		in, out := &in.TaintEscalation, &out.TaintEscalation
+		*out = make([]TaintEscalationStep, len(*in))
+		copy(*out, *in)
+	}
+	in.Rollout.DeepCopyInto(&out.Rollout)
+	in.Schedule.DeepCopyInto(&out.Schedule)
+	out.Drain = in.Drain
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessRuleSpec.
+func (in *NodeReadinessRuleSpec) DeepCopy() *NodeReadinessRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReadinessRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReadinessRuleStatus) DeepCopyInto(out *NodeReadinessRuleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AppliedNodes != nil {
+		in, out := &in.AppliedNodes, &out.AppliedNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailedNodes != nil {
+		in, out := &in.FailedNodes, &out.FailedNodes
+		*out = make([]NodeFailure, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeEvaluations != nil {
+		in, out := &in.NodeEvaluations, &out.NodeEvaluations
+		*out = make([]NodeEvaluation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.NodeCounts.DeepCopyInto(&out.NodeCounts)
+	in.Rollout.DeepCopyInto(&out.Rollout)
+	in.NextTransitionTime.DeepCopyInto(&out.NextTransitionTime)
+	in.DryRunResults.DeepCopyInto(&out.DryRunResults)
+	in.DryRunStartTime.DeepCopyInto(&out.DryRunStartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReadinessRuleStatus.
+func (in *NodeReadinessRuleStatus) DeepCopy() *NodeReadinessRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReadinessRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeScope) DeepCopyInto(out *NodeScope) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeScope.
+func (in *NodeScope) DeepCopy() *NodeScope {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTransition) DeepCopyInto(out *NodeTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTransition.
+func (in *NodeTransition) DeepCopy() *NodeTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rollout) DeepCopyInto(out *Rollout) {
+	*out = *in
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]RolloutStage, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxFailurePercent != nil {
+		in, out := &in.MaxFailurePercent, &out.MaxFailurePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxNewlyTaintedNodes != nil {
+		in, out := &in.MaxNewlyTaintedNodes, &out.MaxNewlyTaintedNodes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rollout.
+func (in *Rollout) DeepCopy() *Rollout {
+	if in == nil {
+		return nil
+	}
+	out := new(Rollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStage) DeepCopyInto(out *RolloutStage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStage.
+func (in *RolloutStage) DeepCopy() *RolloutStage {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	in.StageStartTime.DeepCopyInto(&out.StageStartTime)
+	if in.EnforcedNodes != nil {
+		in, out := &in.EnforcedNodes, &out.EnforcedNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailingNodes != nil {
+		in, out := &in.FailingNodes, &out.FailingNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NewlyTaintedNodes != nil {
+		in, out := &in.NewlyTaintedNodes, &out.NewlyTaintedNodes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleNodeCounts) DeepCopyInto(out *RuleNodeCounts) {
+	*out = *in
+	if in.Matched != nil {
+		in, out := &in.Matched, &out.Matched
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Held != nil {
+		in, out := &in.Held, &out.Held
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Released != nil {
+		in, out := &in.Released, &out.Released
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BootstrapCompleted != nil {
+		in, out := &in.BootstrapCompleted, &out.BootstrapCompleted
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleNodeCounts.
+func (in *RuleNodeCounts) DeepCopy() *RuleNodeCounts {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleNodeCounts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleSchedule) DeepCopyInto(out *RuleSchedule) {
+	*out = *in
+	if in.ActivationWindows != nil {
+		in, out := &in.ActivationWindows, &out.ActivationWindows
+		*out = make([]ActivationWindow, len(*in))
+		copy(*out, *in)
+	}
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleSchedule.
+func (in *RuleSchedule) DeepCopy() *RuleSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaintEscalationStep) DeepCopyInto(out *TaintEscalationStep) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaintEscalationStep.
+func (in *TaintEscalationStep) DeepCopy() *TaintEscalationStep {
+	if in == nil {
+		return nil
+	}
+	out := new(TaintEscalationStep)
+	in.DeepCopyInto(out)
+	return out
+}
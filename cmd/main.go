@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -40,6 +41,7 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	nodereadinessiov1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	nodereadinessiov1beta1 "sigs.k8s.io/node-readiness-controller/api/v1beta1"
 	"sigs.k8s.io/node-readiness-controller/internal/controller"
 	"sigs.k8s.io/node-readiness-controller/internal/info"
 	"sigs.k8s.io/node-readiness-controller/internal/metrics"
@@ -48,35 +50,53 @@ import (
 )
 
 const (
-	defaultKubeAPIQPS               = -1
-	defaultKubeAPIBurst             = -1
-	defaultNodeConcurrentReconciles = 1
-	defaultRuleConcurrentReconciles = 1
+	defaultKubeAPIQPS                 = -1
+	defaultKubeAPIBurst               = -1
+	defaultNodeConcurrentReconciles   = 1
+	defaultRuleConcurrentReconciles   = 1
+	defaultOrphanedTaintSweepInterval = 10 * time.Minute
+	defaultBootstrapGCInterval        = time.Hour
+	defaultBootstrapGCGracePeriod     = 24 * time.Hour
+	defaultBootstrapMigrationInterval = 10 * time.Minute
+	defaultDryRunPromotionMinDuration = 24 * time.Hour
 )
 
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
 
-	metricsAddr              string
-	enableLeaderElection     bool
-	probeAddr                string
-	enableWebhook            bool
-	metricsSecure            bool
-	metricsCertDir           string
-	leaderElectionNamespace  string
-	enableNodeStateMetrics   bool
-	pprofAddr                string
-	kubeAPIQPS               float64
-	kubeAPIBurst             int
-	nodeConcurrentReconciles int
-	ruleConcurrentReconciles int
+	metricsAddr                          string
+	enableLeaderElection                 bool
+	probeAddr                            string
+	enableWebhook                        bool
+	metricsSecure                        bool
+	metricsCertDir                       string
+	leaderElectionNamespace              string
+	enableNodeStateMetrics               bool
+	pprofAddr                            string
+	kubeAPIQPS                           float64
+	kubeAPIBurst                         int
+	nodeConcurrentReconciles             int
+	ruleConcurrentReconciles             int
+	orphanedTaintSweepInterval           time.Duration
+	orphanedTaintGracePeriod             time.Duration
+	bootstrapGCInterval                  time.Duration
+	bootstrapGCGracePeriod               time.Duration
+	bootstrapMigrationInterval           time.Duration
+	disableLegacyBootstrap               bool
+	nodeStatusObjects                    bool
+	webhookMaxImmediateTaints            int
+	webhookDryRunPromotionGate           bool
+	webhookDryRunPromotionMinDuration    time.Duration
+	webhookDryRunPromotionMaxRiskyOps    int
+	webhookDryRunPromotionMaxTaintsToAdd int
 )
 
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(nodereadinessiov1alpha1.AddToScheme(scheme))
+	utilruntime.Must(nodereadinessiov1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -109,6 +129,39 @@ func main() {
 			"Raise on large clusters to reduce readiness-taint latency during node join/condition updates.")
 	flag.IntVar(&ruleConcurrentReconciles, "rule-concurrent-reconciles", defaultRuleConcurrentReconciles,
 		"Maximum number of NodeReadinessRule objects reconciled concurrently.")
+	flag.DurationVar(&orphanedTaintSweepInterval, "orphaned-taint-sweep-interval", defaultOrphanedTaintSweepInterval,
+		"How often nodes are swept for readiness taints that no rule manages. Set to 0 to disable the sweeper.")
+	flag.DurationVar(&orphanedTaintGracePeriod, "orphaned-taint-removal-grace-period", 0,
+		"How long a readiness taint must have been orphaned before the sweeper removes it. "+
+			"Set to 0 to only report orphaned taints.")
+	flag.DurationVar(&bootstrapGCInterval, "bootstrap-annotation-gc-interval", defaultBootstrapGCInterval,
+		"How often nodes are swept for bootstrap completion annotations of deleted rules. Set to 0 to disable the sweeper.")
+	flag.DurationVar(&bootstrapGCGracePeriod, "bootstrap-annotation-gc-grace-period", defaultBootstrapGCGracePeriod,
+		"How long a bootstrap completion annotation must have been orphaned before the sweeper removes it. "+
+			"Set to 0 to remove orphaned annotations as soon as they are found.")
+	flag.DurationVar(&bootstrapMigrationInterval, "legacy-bootstrap-annotation-migration-interval", defaultBootstrapMigrationInterval,
+		"How often nodes are swept for bootstrap completion annotations keyed by rule name, to migrate them to the rule UID key. "+
+			"Set to 0 to disable the migration.")
+	flag.BoolVar(&disableLegacyBootstrap, "disable-legacy-bootstrap-annotations", false,
+		"Stop honouring bootstrap completion annotations keyed by rule name. "+
+			"Only set once node_readiness_legacy_bootstrap_annotations reports zero, or their nodes may be tainted again.")
+	flag.BoolVar(&nodeStatusObjects, "node-status-objects", false,
+		"Record the per-node detail of rule statuses in NodeReadinessStatus objects rather than inline in the rules. "+
+			"Rules recording it inline are migrated once enabled.")
+	flag.IntVar(&webhookMaxImmediateTaints, "webhook-max-immediate-taints", 0,
+		"Reject rules that would immediately taint more than this many nodes unless they are created in dry run. "+
+			"Set to 0 to only warn about the impact.")
+	flag.BoolVar(&webhookDryRunPromotionGate, "webhook-dry-run-promotion-gate", false,
+		"Require rules to be created in dry run, and only allow disabling dry run once the rule has passed the dry run promotion gate. "+
+			"Rules annotated with readiness.k8s.io/skip-dry-run-promotion=true are exempt.")
+	flag.DurationVar(&webhookDryRunPromotionMinDuration, "webhook-dry-run-promotion-min-duration", defaultDryRunPromotionMinDuration,
+		"How long the current spec of a rule must have been in dry run before dry run can be disabled.")
+	flag.IntVar(&webhookDryRunPromotionMaxRiskyOps, "webhook-dry-run-promotion-max-risky-operations", 0,
+		"Maximum number of nodes with missing conditions the dry run of a rule may report for dry run to be disabled. "+
+			"Set to a negative value to disable the threshold.")
+	flag.IntVar(&webhookDryRunPromotionMaxTaintsToAdd, "webhook-dry-run-promotion-max-taints-to-add", -1,
+		"Maximum number of taints the dry run of a rule may report it would add for dry run to be disabled. "+
+			"Set to a negative value to disable the threshold.")
 
 	opts := zap.Options{
 		Development:     true,
@@ -159,10 +212,18 @@ func main() {
 
 	// Create the main RuleReadinessController
 	readinessController := controller.NewRuleReadinessController(mgr, clientset, enableNodeStateMetrics)
+	readinessController.DisableLegacyBootstrapAnnotations = disableLegacyBootstrap
+	readinessController.NodeStatusObjects = nodeStatusObjects
 
 	// Register the scrape-time collector.
 	crmetrics.Registry.MustRegister(metrics.NewReadinessCollector(readinessController))
 
+	// Serve the full dry run results of rules next to the metrics.
+	if err := mgr.AddMetricsServerExtraHandler("/dryrun", readinessController.DryRunHandler(mgr.Elected())); err != nil {
+		setupLog.Error(err, "unable to set up dry run endpoint")
+		os.Exit(1)
+	}
+
 	// Create reconcilers linked to the main controller
 	ruleReconciler := &controller.RuleReconciler{
 		Client:                  mgr.GetClient(),
@@ -189,9 +250,65 @@ func main() {
 		os.Exit(1)
 	}
 
+	simulationReconciler := &controller.SimulationReconciler{
+		Client:     mgr.GetClient(),
+		Controller: readinessController,
+	}
+	if err := simulationReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NodeReadinessRuleSimulation")
+		os.Exit(1)
+	}
+
+	if orphanedTaintSweepInterval > 0 {
+		orphanedTaintSweeper := &controller.OrphanedTaintSweeper{
+			Client:             mgr.GetClient(),
+			Controller:         readinessController,
+			Interval:           orphanedTaintSweepInterval,
+			RemovalGracePeriod: orphanedTaintGracePeriod,
+		}
+		if err := orphanedTaintSweeper.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create orphaned taint sweeper")
+			os.Exit(1)
+		}
+	}
+
+	if bootstrapGCInterval > 0 {
+		bootstrapAnnotationSweeper := &controller.BootstrapAnnotationSweeper{
+			Client:      mgr.GetClient(),
+			Controller:  readinessController,
+			Interval:    bootstrapGCInterval,
+			GracePeriod: bootstrapGCGracePeriod,
+		}
+		if err := bootstrapAnnotationSweeper.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create bootstrap annotation sweeper")
+			os.Exit(1)
+		}
+	}
+
+	if bootstrapMigrationInterval > 0 {
+		legacyBootstrapAnnotationMigrator := &controller.LegacyBootstrapAnnotationMigrator{
+			Client:     mgr.GetClient(),
+			Controller: readinessController,
+			Interval:   bootstrapMigrationInterval,
+		}
+		if err := legacyBootstrapAnnotationMigrator.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create legacy bootstrap annotation migrator")
+			os.Exit(1)
+		}
+	}
+
 	// Setup webhook (conditional based on flag)
 	if enableWebhook {
 		nodeReadinessWebhook := webhook.NewNodeReadinessRuleWebhook(mgr.GetClient())
+		nodeReadinessWebhook.MaxImmediateTaints = webhookMaxImmediateTaints
+		nodeReadinessWebhook.Evaluator = readinessController
+		if webhookDryRunPromotionGate {
+			nodeReadinessWebhook.DryRunPromotion = &webhook.DryRunPromotionPolicy{
+				MinDuration:        webhookDryRunPromotionMinDuration,
+				MaxRiskyOperations: webhookDryRunPromotionMaxRiskyOps,
+				MaxTaintsToAdd:     webhookDryRunPromotionMaxTaintsToAdd,
+			}
+		}
 		if err := nodeReadinessWebhook.SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "NodeReadinessRule")
 			os.Exit(1)
@@ -0,0 +1,41 @@
+#!/usr/bin/env bash
+
+# Copyright The Kubernetes Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# This script rewrites every NodeReadinessRule in the storage version of its
+# CRD, then records that version as the only one in the CRD's
+# status.storedVersions, so that the other versions can later be removed from
+# the CRD. Status, finalizers and UIDs are preserved, and with them the
+# bootstrap completions recorded on Nodes.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+KUBECTL="${KUBECTL:-kubectl}"
+CRD="nodereadinessrules.readiness.node.x-k8s.io"
+
+storage_version="$(${KUBECTL} get crd "${CRD}" -o jsonpath='{.spec.versions[?(@.storage==true)].name}')"
+echo "Rewriting NodeReadinessRules in ${storage_version}"
+
+# An empty patch does not change the object, but the API server still encodes
+# it in the storage version and writes it back when the stored bytes differ.
+for rule in $(${KUBECTL} get "${CRD}" -o name); do
+  ${KUBECTL} patch "${rule}" --type=merge --patch '{}'
+done
+
+${KUBECTL} patch crd "${CRD}" --subresource=status --type=merge \
+  --patch "{\"status\":{\"storedVersions\":[\"${storage_version}\"]}}"
+echo "storedVersions of ${CRD} set to [${storage_version}]"
@@ -0,0 +1,94 @@
+//go:build codegen
+
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// models-schema writes the OpenAPI v2 definitions generated by openapi-gen
+// in _output/openapi to stdout, in the format expected by the
+// --openapi-schema flag of applyconfiguration-gen. It is run by
+// hack/update-codegen.sh after generating the definitions.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	"sigs.k8s.io/node-readiness-controller/_output/openapi"
+)
+
+func main() {
+	if err := output(); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func output() error {
+	refFunc := func(name string) spec.Ref {
+		return spec.MustCreateRef("#/definitions/" + friendlyName(name))
+	}
+	defs := openapi.GetOpenAPIDefinitions(refFunc)
+	schemaDefs := make(map[string]spec.Schema, len(defs))
+	for name, def := range defs {
+		// Types with a custom OpenAPI schema, such as metav1.Time, may embed
+		// a v2 schema alongside their v3 one. Prefer it, since the output
+		// is an OpenAPI v2 document.
+		if schema, ok := def.Schema.Extensions[common.ExtensionV2Schema]; ok {
+			if v2Schema, ok := schema.(spec.Schema); ok {
+				schemaDefs[friendlyName(name)] = v2Schema
+				continue
+			}
+		}
+		schemaDefs[friendlyName(name)] = def.Schema
+	}
+	data, err := json.Marshal(&spec.Swagger{
+		SwaggerProps: spec.SwaggerProps{
+			Definitions: schemaDefs,
+			Info: &spec.Info{
+				InfoProps: spec.InfoProps{
+					Title:   "node-readiness-controller",
+					Version: "unversioned",
+				},
+			},
+			Swagger: "2.0",
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal definitions: %w", err)
+	}
+	_, err = os.Stdout.Write(data)
+	return err
+}
+
+// friendlyName converts a Go type name into the name the API server uses for
+// it in OpenAPI documents, e.g. io.k8s.api.core.v1.Taint for
+// k8s.io/api/core/v1.Taint.
+func friendlyName(name string) string {
+	nameParts := strings.Split(name, "/")
+	if len(nameParts) > 0 && strings.Contains(nameParts[0], ".") {
+		parts := strings.Split(nameParts[0], ".")
+		for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+			parts[i], parts[j] = parts[j], parts[i]
+		}
+		nameParts[0] = strings.Join(parts, ".")
+	}
+	return strings.Join(nameParts, ".")
+}
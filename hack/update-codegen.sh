@@ -0,0 +1,63 @@
+#!/usr/bin/env bash
+
+# Copyright The Kubernetes Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# This script generates the clientset, listers, informers and apply
+# configurations for the API types marked with +genclient into pkg/client.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+SCRIPT_ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd -P)"
+cd "${SCRIPT_ROOT}"
+
+# Use the code-generator release matching client-go, so the generated code
+# compiles against the client-go version in go.mod.
+CODEGEN_VERSION="${CODEGEN_VERSION:-$(go list -m -f '{{.Version}}' k8s.io/client-go)}"
+CODEGEN_PKG="${CODEGEN_PKG:-$(go mod download -json "k8s.io/code-generator@${CODEGEN_VERSION}" | grep '"Dir"' | cut -d '"' -f 4)}"
+
+# shellcheck source=/dev/null
+source "${CODEGEN_PKG}/kube_codegen.sh"
+
+# applyconfiguration-gen needs the OpenAPI schema of the API types to generate
+# the Extract functions and the type converter used by the fake clientset for
+# server-side apply. Generate the OpenAPI definitions in a temporary package,
+# including k8s.io/api/core/v1 for the Taint type, and convert them.
+OPENAPI_DIR="${SCRIPT_ROOT}/_output/openapi"
+trap 'rm -rf "${SCRIPT_ROOT}/_output"' EXIT
+mkdir -p "${OPENAPI_DIR}"
+
+kube::codegen::gen_openapi \
+  --output-dir "${OPENAPI_DIR}" \
+  --output-pkg "sigs.k8s.io/node-readiness-controller/_output/openapi" \
+  --extra-pkgs "k8s.io/api/core/v1" \
+  --report-filename "${OPENAPI_DIR}/api-violations.list" \
+  --update-report \
+  --boilerplate "${SCRIPT_ROOT}/hack/boilerplate/boilerplate.generatego.txt" \
+  "${SCRIPT_ROOT}/api"
+
+go run -tags codegen ./hack/models-schema > "${OPENAPI_DIR}/models-schema.json"
+
+kube::codegen::gen_client \
+  --with-watch \
+  --with-applyconfig \
+  --applyconfig-openapi-schema "${OPENAPI_DIR}/models-schema.json" \
+  --applyconfig-externals "k8s.io/api/core/v1.Taint:k8s.io/client-go/applyconfigurations/core/v1" \
+  --one-input-api "api" \
+  --output-dir "${SCRIPT_ROOT}/pkg/client" \
+  --output-pkg "sigs.k8s.io/node-readiness-controller/pkg/client" \
+  --boilerplate "${SCRIPT_ROOT}/hack/boilerplate/boilerplate.generatego.txt" \
+  "${SCRIPT_ROOT}"
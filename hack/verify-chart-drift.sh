@@ -28,3 +28,11 @@ make manifests
 diff -u \
   config/crd/bases/readiness.node.x-k8s.io_nodereadinessrules.yaml \
   charts/node-readiness-controller/crds/nodereadinessrules.readiness.node.x-k8s.io.yaml
+
+diff -u \
+  config/crd/bases/readiness.node.x-k8s.io_nodereadinessstatuses.yaml \
+  charts/node-readiness-controller/crds/nodereadinessstatuses.readiness.node.x-k8s.io.yaml
+
+diff -u \
+  config/crd/bases/readiness.node.x-k8s.io_nodereadinessrulesimulations.yaml \
+  charts/node-readiness-controller/crds/nodereadinessrulesimulations.readiness.node.x-k8s.io.yaml
@@ -0,0 +1,121 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// adoptsBootstrapCompletions reports whether the rule may adopt bootstrap
+// completions recorded by previous rules, and so whether its own must outlive it.
+func adoptsBootstrapCompletions(rule *readinessv1alpha1.NodeReadinessRule) bool {
+	return rule.Spec.BootstrapID != "" ||
+		rule.Spec.GetBootstrapAdoptionPolicy() == readinessv1alpha1.BootstrapAdoptionPolicySameRuleName
+}
+
+// claimsBootstrapCompletion reports whether the rule recognises a completion
+// recorded by another rule as its own: one with the same bootstrapID or, under
+// the SameRuleName adoption policy, one recorded by a rule with the same name.
+func claimsBootstrapCompletion(rule *readinessv1alpha1.NodeReadinessRule, completion bootstrapCompletion) bool {
+	if rule.Spec.BootstrapID != "" && completion.BootstrapID == rule.Spec.BootstrapID {
+		return true
+	}
+	return rule.Spec.GetBootstrapAdoptionPolicy() == readinessv1alpha1.BootstrapAdoptionPolicySameRuleName &&
+		completion.RuleName != "" && completion.RuleName == rule.Name
+}
+
+// adoptableBootstrapAnnotation returns the key and value of a completion
+// annotation recorded by a previous rule that the bootstrap-only rule claims,
+// when the node carries none under the rule's own UID.
+func adoptableBootstrapAnnotation(node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule) (string, bootstrapCompletion, bool) {
+	if rule.Spec.EnforcementMode != readinessv1alpha1.EnforcementModeBootstrapOnly || !adoptsBootstrapCompletions(rule) {
+		return "", bootstrapCompletion{}, false
+	}
+	if _, exists := node.Annotations[bootstrapAnnotationKey(rule.GetUID())]; exists {
+		return "", bootstrapCompletion{}, false
+	}
+
+	for key, value := range node.Annotations {
+		suffix, ok := strings.CutPrefix(key, bootstrapAnnotationPrefix)
+		if !ok || suffix == string(rule.GetUID()) {
+			continue
+		}
+		if completion := parseBootstrapAnnotationValue(value); claimsBootstrapCompletion(rule, completion) {
+			return key, completion, true
+		}
+	}
+	return "", bootstrapCompletion{}, false
+}
+
+// adoptBootstrapCompletion migrates a completion annotation recorded by a
+// previous incarnation of the rule, e.g. before it was restored from a backup
+// or recreated with a new UID, to the rule's UID key. The node is then treated
+// as having completed bootstrap instead of being tainted again.
+func (r *RuleReadinessController) adoptBootstrapCompletion(ctx context.Context, node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule) error {
+	if _, _, ok := adoptableBootstrapAnnotation(node, rule); !ok {
+		return nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	var adoptedKey string
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		adoptedKey = ""
+
+		latestNode := &corev1.Node{}
+		if err := r.Get(ctx, client.ObjectKey{Name: node.Name}, latestNode); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		key, completion, ok := adoptableBootstrapAnnotation(latestNode, rule)
+		if !ok {
+			return nil
+		}
+
+		stored := latestNode.DeepCopy()
+		completion.RuleName = rule.Name
+		completion.BootstrapID = rule.Spec.BootstrapID
+		delete(latestNode.Annotations, key)
+		latestNode.Annotations[bootstrapAnnotationKey(rule.GetUID())] = completion.String()
+		if err := r.Patch(ctx, latestNode, client.MergeFromWithOptions(stored, client.MergeFromWithOptimisticLock{})); err != nil {
+			return err
+		}
+
+		// Update the original node reference with the latest state
+		*node = *latestNode
+
+		adoptedKey = key
+		return nil
+	})
+	if err != nil || adoptedKey == "" {
+		return err
+	}
+
+	log.Info("Adopted bootstrap completion of a previous rule", "node", node.Name, "rule", rule.Name,
+		"uid", rule.GetUID(), "annotation", adoptedKey)
+	r.EventRecorder.Eventf(node, nil, corev1.EventTypeNormal, "BootstrapAdopted", "AdoptBootstrap",
+		"Bootstrap completion recorded in annotation '%s' adopted by rule '%s'", adoptedKey, rule.Name)
+	return nil
+}
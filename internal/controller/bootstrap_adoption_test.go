@@ -0,0 +1,151 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// recreatedRule returns the gpu rule in bootstrap-only mode, recreated with a new UID.
+func recreatedRule(bootstrapID string, policy readinessv1alpha1.BootstrapAdoptionPolicy) *readinessv1alpha1.NodeReadinessRule {
+	rule := gpuRule()
+	rule.UID = "new-uid"
+	rule.Spec.EnforcementMode = readinessv1alpha1.EnforcementModeBootstrapOnly
+	rule.Spec.BootstrapID = bootstrapID
+	rule.Spec.BootstrapAdoptionPolicy = policy
+	return rule
+}
+
+// nodeBootstrappedByOldRule returns a node that completed bootstrap for the
+// previous incarnation of the gpu rule.
+func nodeBootstrappedByOldRule(bootstrapID string) *corev1.Node {
+	node := gpuNode("gpu-node", false)
+	node.Annotations = map[string]string{
+		bootstrapAnnotationKey("old-uid"): bootstrapCompletion{RuleName: "gpu-ready", BootstrapID: bootstrapID, BootID: "boot-1"}.String(),
+	}
+	return node
+}
+
+func TestAdoptBootstrapCompletion(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		rule        *readinessv1alpha1.NodeReadinessRule
+		node        *corev1.Node
+		wantAdopted bool
+	}{
+		{
+			name:        "same bootstrapID",
+			rule:        recreatedRule("gpu", ""),
+			node:        nodeBootstrappedByOldRule("gpu"),
+			wantAdopted: true,
+		},
+		{
+			name: "different bootstrapID",
+			rule: recreatedRule("gpu-v2", ""),
+			node: nodeBootstrappedByOldRule("gpu"),
+		},
+		{
+			name:        "same rule name",
+			rule:        recreatedRule("", readinessv1alpha1.BootstrapAdoptionPolicySameRuleName),
+			node:        nodeBootstrappedByOldRule(""),
+			wantAdopted: true,
+		},
+		{
+			name: "same rule name without the adoption policy",
+			rule: recreatedRule("", ""),
+			node: nodeBootstrappedByOldRule(""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(tt.node).Build()
+			recorder := events.NewFakeRecorder(10)
+			c := &RuleReadinessController{Client: fc, EventRecorder: recorder}
+
+			g.Expect(c.nodeHasBootstrapAnnotation(tt.node, tt.rule)).To(Equal(tt.wantAdopted))
+			g.Expect(c.adoptBootstrapCompletion(ctx, tt.node, tt.rule)).To(Succeed())
+
+			updated := &corev1.Node{}
+			g.Expect(fc.Get(ctx, client.ObjectKey{Name: tt.node.Name}, updated)).To(Succeed())
+			if !tt.wantAdopted {
+				g.Expect(updated.Annotations).To(HaveKey(bootstrapAnnotationKey("old-uid")))
+				g.Expect(recorder.Events).To(BeEmpty())
+				return
+			}
+
+			g.Expect(updated.Annotations).To(HaveLen(1))
+			completion := parseBootstrapAnnotationValue(updated.Annotations[bootstrapAnnotationKey("new-uid")])
+			g.Expect(completion.RuleName).To(Equal("gpu-ready"))
+			g.Expect(completion.BootstrapID).To(Equal(tt.rule.Spec.BootstrapID))
+			g.Expect(completion.BootID).To(Equal("boot-1"))
+			g.Expect(<-recorder.Events).To(ContainSubstring("BootstrapAdopted"))
+		})
+	}
+}
+
+func TestAddTaintBySpec_AdoptableCompletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	node := nodeBootstrappedByOldRule("gpu")
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+	c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+
+	added, err := c.addTaintBySpec(ctx, node, recreatedRule("gpu", ""))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(added).To(BeFalse())
+}
+
+func TestProcessNodeAgainstAllRules_SkipsAdoptableCompletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	node := nodeBootstrappedByOldRule("gpu")
+	rule := recreatedRule("gpu", "")
+	rule.Spec.Conditions = []readinessv1alpha1.ConditionRequirement{
+		{Type: "GPUReady", RequiredStatus: corev1.ConditionTrue},
+	}
+	r := newNodeStatusController(t, node, rule)
+	recorder := events.NewFakeRecorder(10)
+	r.EventRecorder = recorder
+	r.ruleCache = map[string]*readinessv1alpha1.NodeReadinessRule{rule.Name: rule.DeepCopy()}
+
+	// The node does not report GPUReady, but completed bootstrap for the
+	// previous incarnation of the rule, so the rule skips it like a node it
+	// completed itself; the rule reconciler adopts the completion.
+	_, err := r.processNodeAgainstAllRules(ctx, node)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	updated := &corev1.Node{}
+	g.Expect(r.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+	g.Expect(updated.Spec.Taints).To(BeEmpty())
+	g.Expect(updated.Annotations).To(Equal(node.Annotations))
+	g.Expect(recorder.Events).To(BeEmpty())
+}
@@ -0,0 +1,218 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/flowcontrol"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// bootstrapAnnotationGCQPS limits how many Nodes per second are patched to
+// remove the bootstrap completion annotations of deleted rules.
+const bootstrapAnnotationGCQPS = 10
+
+// staleBootstrapAnnotations returns the bootstrap completion annotation keys
+// on the node that isStale accepts, given the node's name, their suffix, a
+// rule UID or a legacy rule name, and their decoded value.
+func staleBootstrapAnnotations(node *corev1.Node, isStale func(nodeName, suffix string, completion bootstrapCompletion) bool) []string {
+	var keys []string
+	for key, value := range node.Annotations {
+		suffix, ok := strings.CutPrefix(key, bootstrapAnnotationPrefix)
+		if ok && isStale(node.Name, suffix, parseBootstrapAnnotationValue(value)) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// bootstrapAnnotationGCLimiter returns the rate limiter shared by all
+// removals of stale bootstrap completion annotations.
+func (r *RuleReadinessController) bootstrapAnnotationGCLimiter() flowcontrol.RateLimiter {
+	r.bootstrapAnnotationGCOnce.Do(func() {
+		r.bootstrapAnnotationGCRateLimiter = flowcontrol.NewTokenBucketRateLimiter(bootstrapAnnotationGCQPS, bootstrapAnnotationGCQPS)
+	})
+	return r.bootstrapAnnotationGCRateLimiter
+}
+
+// removeStaleBootstrapAnnotations removes the bootstrap completion
+// annotations isStale accepts from the nodes, rate limited so that large
+// clusters are not flooded with patches. It returns how many annotations
+// were removed.
+func (r *RuleReadinessController) removeStaleBootstrapAnnotations(ctx context.Context, nodes []corev1.Node, isStale func(nodeName, suffix string, completion bootstrapCompletion) bool) (int, error) {
+	limiter := r.bootstrapAnnotationGCLimiter()
+
+	removed := 0
+	var errs []error
+	for i := range nodes {
+		keys := staleBootstrapAnnotations(&nodes[i], isStale)
+		if len(keys) == 0 {
+			continue
+		}
+		if err := limiter.Wait(ctx); err != nil {
+			return removed, err
+		}
+		if err := r.removeNodeAnnotations(ctx, nodes[i].Name, keys...); err != nil {
+			errs = append(errs, fmt.Errorf("node %s: %w", nodes[i].Name, err))
+			continue
+		}
+		removed += len(keys)
+	}
+	return removed, errors.Join(errs...)
+}
+
+// removeBootstrapAnnotationsForRule removes a deleted rule's bootstrap
+// completion annotations, keyed by its UID or its legacy name, from all nodes.
+// Those of a rule that adopts completions of previous rules are kept for a
+// recreated rule to adopt; the sweeper removes them once no rule claims them.
+func (r *RuleReadinessController) removeBootstrapAnnotationsForRule(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeList *corev1.NodeList) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if adoptsBootstrapCompletions(rule) {
+		log.Info("Keeping bootstrap completion annotations of deleted rule for adoption", "rule", rule.Name)
+		return nil
+	}
+
+	removed, err := r.removeStaleBootstrapAnnotations(ctx, nodeList.Items, func(_, suffix string, _ bootstrapCompletion) bool {
+		return suffix == string(rule.UID) || suffix == rule.Name
+	})
+	if removed > 0 {
+		log.Info("Removed bootstrap completion annotations of deleted rule", "rule", rule.Name, "annotations", removed)
+	}
+	return err
+}
+
+// orphanedAnnotation identifies a bootstrap completion annotation on a Node.
+type orphanedAnnotation struct {
+	nodeName string
+	key      string
+}
+
+// BootstrapAnnotationSweeper periodically removes bootstrap completion
+// annotations of rules that no longer exist, e.g. because they were deleted
+// while the controller was not running, once they have been orphaned for a
+// grace period.
+type BootstrapAnnotationSweeper struct {
+	client.Client
+	Controller *RuleReadinessController
+
+	// Interval is how often nodes are swept.
+	Interval time.Duration
+	// GracePeriod is how long an annotation must have been found orphaned
+	// before it is removed, leaving time to recreate a deleted rule that
+	// adopts it. Zero removes orphaned annotations as soon as they are found.
+	GracePeriod time.Duration
+
+	// orphanedSince records when each orphaned annotation was first found.
+	orphanedSince map[orphanedAnnotation]time.Time
+}
+
+// SetupWithManager adds the sweeper to the Manager.
+func (s *BootstrapAnnotationSweeper) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(s)
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, so that only
+// the leader sweeps.
+func (s *BootstrapAnnotationSweeper) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable.
+func (s *BootstrapAnnotationSweeper) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("bootstrap-annotation-sweeper")
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := s.sweep(ctx); err != nil {
+			log.Error(err, "Failed to sweep bootstrap completion annotations")
+		}
+	}, s.Interval)
+	return nil
+}
+
+// sweep removes the bootstrap completion annotations whose suffix has matched
+// neither the UID nor the name of any existing rule, and that no existing rule
+// has claimed for adoption, for the grace period.
+func (s *BootstrapAnnotationSweeper) sweep(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if s.orphanedSince == nil {
+		s.orphanedSince = make(map[orphanedAnnotation]time.Time)
+	}
+
+	// Nodes are listed before rules, so that the annotation of a rule created
+	// in between is not mistaken for a stale one.
+	nodeList := &corev1.NodeList{}
+	if err := s.List(ctx, nodeList); err != nil {
+		return err
+	}
+	ruleList := &readinessv1alpha1.NodeReadinessRuleList{}
+	if err := s.List(ctx, ruleList); err != nil {
+		return err
+	}
+
+	live := sets.New[string]()
+	for _, rule := range ruleList.Items {
+		live.Insert(string(rule.UID), rule.Name)
+	}
+
+	now := time.Now()
+	found := make(map[orphanedAnnotation]bool)
+	removed, err := s.Controller.removeStaleBootstrapAnnotations(ctx, nodeList.Items, func(nodeName, suffix string, completion bootstrapCompletion) bool {
+		if live.Has(suffix) {
+			return false
+		}
+		for i := range ruleList.Items {
+			if claimsBootstrapCompletion(&ruleList.Items[i], completion) {
+				return false
+			}
+		}
+
+		orphan := orphanedAnnotation{nodeName: nodeName, key: bootstrapAnnotationPrefix + suffix}
+		found[orphan] = true
+		since, seen := s.orphanedSince[orphan]
+		if !seen {
+			since = now
+			s.orphanedSince[orphan] = since
+			log.V(3).Info("Found orphaned bootstrap completion annotation", "node", nodeName, "annotation", orphan.key)
+		}
+		return now.Sub(since) >= s.GracePeriod
+	})
+	if removed > 0 {
+		log.Info("Removed bootstrap completion annotations of deleted rules", "annotations", removed)
+	}
+
+	// Forget annotations that were removed, claimed again or are no longer present.
+	for orphan := range s.orphanedSince {
+		if !found[orphan] {
+			delete(s.orphanedSince, orphan)
+		}
+	}
+	return err
+}
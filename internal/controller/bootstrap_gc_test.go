@@ -0,0 +1,171 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// bootstrappedNode returns a node carrying bootstrap completion annotations
+// of a live rule and of a deleted one, in both key formats, plus an unrelated annotation.
+func bootstrappedNode(name string) *corev1.Node {
+	node := gpuNode(name, false)
+	node.Annotations = map[string]string{
+		bootstrapAnnotationKey("live-uid"):         bootstrapAnnotationValue("live-rule"),
+		legacyBootstrapAnnotationKey("live-rule"):  "true",
+		bootstrapAnnotationKey("gone-uid"):         bootstrapAnnotationValue("gone-rule"),
+		legacyBootstrapAnnotationKey("gone-rule"):  "true",
+		"example.com/unrelated":                    "value",
+		bootstrapAnnotationPrefix + "other-prefix": "true",
+	}
+	return node
+}
+
+func liveRule() *readinessv1alpha1.NodeReadinessRule {
+	rule := gpuRule()
+	rule.Name = "live-rule"
+	rule.UID = "live-uid"
+	return rule
+}
+
+func TestRemoveBootstrapAnnotationsForRule(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	node := bootstrappedNode("gpu-node")
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+	c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+	rule := gpuRule()
+	rule.Name = "gone-rule"
+	rule.UID = "gone-uid"
+
+	g.Expect(c.removeBootstrapAnnotationsForRule(ctx, rule, &corev1.NodeList{Items: []corev1.Node{*node}})).To(Succeed())
+
+	updated := &corev1.Node{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+	g.Expect(updated.Annotations).To(HaveLen(4))
+	g.Expect(updated.Annotations).NotTo(HaveKey(bootstrapAnnotationKey("gone-uid")))
+	g.Expect(updated.Annotations).NotTo(HaveKey(legacyBootstrapAnnotationKey("gone-rule")))
+}
+
+func TestBootstrapAnnotationSweeper(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	untouched := gpuNode("untouched", false)
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).
+		WithObjects(liveRule(), bootstrappedNode("node-1"), bootstrappedNode("node-2"), untouched).Build()
+	s := &BootstrapAnnotationSweeper{
+		Client:     fc,
+		Controller: &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)},
+	}
+
+	g.Expect(s.sweep(ctx)).To(Succeed())
+
+	for _, name := range []string{"node-1", "node-2"} {
+		node := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: name}, node)).To(Succeed())
+		g.Expect(node.Annotations).To(Equal(map[string]string{
+			bootstrapAnnotationKey("live-uid"):        bootstrapAnnotationValue("live-rule"),
+			legacyBootstrapAnnotationKey("live-rule"): "true",
+			"example.com/unrelated":                   "value",
+		}))
+	}
+
+	node := &corev1.Node{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: "untouched"}, node)).To(Succeed())
+	g.Expect(node.ResourceVersion).To(Equal(untouched.ResourceVersion))
+}
+
+func TestBootstrapAnnotationSweeper_GracePeriod(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).
+		WithObjects(liveRule(), bootstrappedNode("node-1")).Build()
+	s := &BootstrapAnnotationSweeper{
+		Client:      fc,
+		Controller:  &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)},
+		GracePeriod: time.Hour,
+	}
+
+	// Orphaned annotations are only recorded when first found.
+	g.Expect(s.sweep(ctx)).To(Succeed())
+	node := &corev1.Node{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: "node-1"}, node)).To(Succeed())
+	g.Expect(node.Annotations).To(Equal(bootstrappedNode("node-1").Annotations))
+	orphaned := orphanedAnnotation{nodeName: "node-1", key: bootstrapAnnotationKey("gone-uid")}
+	g.Expect(s.orphanedSince).To(HaveKey(orphaned))
+	g.Expect(s.orphanedSince).To(HaveLen(3))
+
+	// They are removed once orphaned for the grace period.
+	for orphan := range s.orphanedSince {
+		s.orphanedSince[orphan] = time.Now().Add(-time.Hour)
+	}
+	g.Expect(s.sweep(ctx)).To(Succeed())
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: "node-1"}, node)).To(Succeed())
+	g.Expect(node.Annotations).To(Equal(map[string]string{
+		bootstrapAnnotationKey("live-uid"):        bootstrapAnnotationValue("live-rule"),
+		legacyBootstrapAnnotationKey("live-rule"): "true",
+		"example.com/unrelated":                   "value",
+	}))
+
+	// Removed annotations are forgotten.
+	g.Expect(s.sweep(ctx)).To(Succeed())
+	g.Expect(s.orphanedSince).To(BeEmpty())
+}
+
+func TestBootstrapAnnotationsKeptForAdoption(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	node := bootstrappedNode("gpu-node")
+	node.Annotations[bootstrapAnnotationKey("gone-uid")] = bootstrapCompletion{RuleName: "gone-rule", BootstrapID: "gone"}.String()
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+	c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+	rule := gpuRule()
+	rule.Name = "gone-rule"
+	rule.UID = "gone-uid"
+	rule.Spec.BootstrapID = "gone"
+
+	// A deleted rule with a bootstrapID keeps its annotations for a recreated rule.
+	g.Expect(c.removeBootstrapAnnotationsForRule(ctx, rule, &corev1.NodeList{Items: []corev1.Node{*node}})).To(Succeed())
+	updated := &corev1.Node{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+	g.Expect(updated.Annotations).To(HaveKey(bootstrapAnnotationKey("gone-uid")))
+
+	// The sweeper keeps them while a rule claims them.
+	recreated := liveRule()
+	recreated.Spec.EnforcementMode = readinessv1alpha1.EnforcementModeBootstrapOnly
+	recreated.Spec.BootstrapID = "gone"
+	g.Expect(fc.Create(ctx, recreated)).To(Succeed())
+	s := &BootstrapAnnotationSweeper{Client: fc, Controller: c}
+	g.Expect(s.sweep(ctx)).To(Succeed())
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+	g.Expect(updated.Annotations).To(HaveKey(bootstrapAnnotationKey("gone-uid")))
+	g.Expect(updated.Annotations).NotTo(HaveKey(legacyBootstrapAnnotationKey("gone-rule")))
+}
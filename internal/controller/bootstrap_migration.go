@@ -0,0 +1,184 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/metrics"
+)
+
+// legacyBootstrapAnnotations returns the legacy bootstrap completion
+// annotation keys on the node, mapped to the rule named by their suffix.
+// Annotations of rules that do not exist are left to the annotation sweeper.
+func legacyBootstrapAnnotations(node *corev1.Node, rulesByName map[string]*readinessv1alpha1.NodeReadinessRule) map[string]*readinessv1alpha1.NodeReadinessRule {
+	legacy := make(map[string]*readinessv1alpha1.NodeReadinessRule)
+	for key := range node.Annotations {
+		suffix, ok := strings.CutPrefix(key, bootstrapAnnotationPrefix)
+		if !ok {
+			continue
+		}
+		if rule, exists := rulesByName[suffix]; exists {
+			legacy[key] = rule
+		}
+	}
+	return legacy
+}
+
+// LegacyBootstrapAnnotationMigrator periodically rewrites bootstrap completion
+// annotations keyed by rule name into the UID-based key, so that the legacy
+// fallback can eventually be disabled. Progress is reported by the
+// node_readiness_legacy_bootstrap_annotations metric.
+type LegacyBootstrapAnnotationMigrator struct {
+	client.Client
+	Controller *RuleReadinessController
+
+	// Interval is how often nodes are swept.
+	Interval time.Duration
+}
+
+// SetupWithManager adds the migrator to the Manager.
+func (m *LegacyBootstrapAnnotationMigrator) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(m)
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, so that only
+// the leader migrates.
+func (m *LegacyBootstrapAnnotationMigrator) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable.
+func (m *LegacyBootstrapAnnotationMigrator) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("legacy-bootstrap-annotation-migrator")
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := m.sweep(ctx); err != nil {
+			log.Error(err, "Failed to migrate legacy bootstrap completion annotations")
+		}
+	}, m.Interval)
+	return nil
+}
+
+// sweep migrates the legacy bootstrap completion annotations of all existing
+// rules, rate limited like the removal of stale annotations, and records how
+// many are left.
+func (m *LegacyBootstrapAnnotationMigrator) sweep(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	nodeList := &corev1.NodeList{}
+	if err := m.List(ctx, nodeList); err != nil {
+		return err
+	}
+	// Rules being deleted remove their own annotations, whatever the key.
+	ruleList := &readinessv1alpha1.NodeReadinessRuleList{}
+	if err := m.List(ctx, ruleList); err != nil {
+		return err
+	}
+	rulesByName := make(map[string]*readinessv1alpha1.NodeReadinessRule, len(ruleList.Items))
+	for i := range ruleList.Items {
+		if rule := &ruleList.Items[i]; rule.DeletionTimestamp.IsZero() {
+			rulesByName[rule.Name] = rule
+		}
+	}
+
+	limiter := m.Controller.bootstrapAnnotationGCLimiter()
+	remaining, migrated := 0, 0
+	var errs []error
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		legacy := len(legacyBootstrapAnnotations(node, rulesByName))
+		if legacy == 0 {
+			continue
+		}
+		remaining += legacy
+
+		if err := limiter.Wait(ctx); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		n, err := m.migrateNode(ctx, node.Name, rulesByName)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("node %s: %w", node.Name, err))
+			continue
+		}
+		// A migrated node has no legacy annotations of existing rules left.
+		remaining -= legacy
+		migrated += n
+	}
+
+	metrics.LegacyBootstrapAnnotations.Set(float64(remaining))
+	if migrated > 0 {
+		metrics.LegacyBootstrapAnnotationsMigrated.Add(float64(migrated))
+		log.Info("Migrated legacy bootstrap completion annotations", "annotations", migrated, "remaining", remaining)
+	}
+	if remaining > 0 && m.Controller.DisableLegacyBootstrapAnnotations {
+		log.Info("Legacy bootstrap completion annotations remain while the legacy fallback is disabled; "+
+			"their nodes may be tainted again", "remaining", remaining)
+	}
+	return errors.Join(errs...)
+}
+
+// migrateNode rewrites the node's legacy bootstrap completion annotations
+// into the UID-based key, in a single patch. The migrated completion records
+// the node's current boot and kubelet version, like a bootstrap completing
+// now, so that the rule's re-arm triggers work for it. An existing UID-based
+// annotation is kept as is. It returns how many legacy annotations were migrated.
+func (m *LegacyBootstrapAnnotationMigrator) migrateNode(ctx context.Context, nodeName string, rulesByName map[string]*readinessv1alpha1.NodeReadinessRule) (int, error) {
+	migrated := 0
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		migrated = 0
+
+		latestNode := &corev1.Node{}
+		if err := m.Get(ctx, client.ObjectKey{Name: nodeName}, latestNode); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		legacy := legacyBootstrapAnnotations(latestNode, rulesByName)
+		if len(legacy) == 0 {
+			return nil
+		}
+
+		stored := latestNode.DeepCopy()
+		for key, rule := range legacy {
+			uidKey := bootstrapAnnotationKey(rule.GetUID())
+			if _, exists := latestNode.Annotations[uidKey]; !exists {
+				latestNode.Annotations[uidKey] = nodeBootstrapAnnotationValue(rule, latestNode)
+			}
+			delete(latestNode.Annotations, key)
+		}
+		if err := m.Patch(ctx, latestNode, client.MergeFromWithOptions(stored, client.MergeFromWithOptimisticLock{})); err != nil {
+			return err
+		}
+
+		migrated = len(legacy)
+		return nil
+	})
+	return migrated, err
+}
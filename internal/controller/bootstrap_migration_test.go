@@ -0,0 +1,117 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/metrics"
+)
+
+func TestLegacyBootstrapAnnotationMigrator(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	// node-1 only has the legacy key; node-2 has both, with a richer UID-based value.
+	uidValue := bootstrapCompletion{RuleName: "live-rule", BootID: "boot-1"}.String()
+	legacyOnly := gpuNode("node-1", false)
+	legacyOnly.Annotations = map[string]string{
+		legacyBootstrapAnnotationKey("live-rule"): "true",
+		legacyBootstrapAnnotationKey("gone-rule"): "true",
+	}
+	legacyOnly.Status.NodeInfo = corev1.NodeSystemInfo{BootID: "boot-1", KubeletVersion: "v1.35.0"}
+	both := gpuNode("node-2", false)
+	both.Annotations = map[string]string{
+		legacyBootstrapAnnotationKey("live-rule"): "true",
+		bootstrapAnnotationKey("live-uid"):        uidValue,
+	}
+	migrated := gpuNode("node-3", false)
+	migrated.Annotations = map[string]string{bootstrapAnnotationKey("live-uid"): uidValue}
+
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).
+		WithObjects(liveRule(), legacyOnly, both, migrated).Build()
+	m := &LegacyBootstrapAnnotationMigrator{
+		Client:     fc,
+		Controller: &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)},
+	}
+
+	migratedBefore := testutil.ToFloat64(metrics.LegacyBootstrapAnnotationsMigrated)
+	g.Expect(m.sweep(ctx)).To(Succeed())
+
+	node := &corev1.Node{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: "node-1"}, node)).To(Succeed())
+	g.Expect(node.Annotations).To(Equal(map[string]string{
+		bootstrapAnnotationKey("live-uid"):        nodeBootstrapAnnotationValue(liveRule(), legacyOnly),
+		legacyBootstrapAnnotationKey("gone-rule"): "true",
+	}))
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: "node-2"}, node)).To(Succeed())
+	g.Expect(node.Annotations).To(Equal(map[string]string{bootstrapAnnotationKey("live-uid"): uidValue}))
+
+	g.Expect(testutil.ToFloat64(metrics.LegacyBootstrapAnnotations)).To(BeZero())
+	g.Expect(testutil.ToFloat64(metrics.LegacyBootstrapAnnotationsMigrated)).To(Equal(migratedBefore + 2))
+}
+
+func TestLegacyBootstrapAnnotationMigrator_RearmOnReboot(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	rule := liveRule()
+	rule.Spec.EnforcementMode = readinessv1alpha1.EnforcementModeBootstrapOnly
+	rule.Spec.BootstrapRearmTriggers = []readinessv1alpha1.BootstrapRearmTrigger{readinessv1alpha1.BootstrapRearmTriggerNodeReboot}
+	node := gpuNode("node-1", false)
+	node.Annotations = map[string]string{legacyBootstrapAnnotationKey("live-rule"): "true"}
+	node.Status.NodeInfo.BootID = "boot-1"
+
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(rule, node).Build()
+	c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+	m := &LegacyBootstrapAnnotationMigrator{Client: fc, Controller: c}
+	g.Expect(m.sweep(ctx)).To(Succeed())
+
+	// The migrated completion records the node's boot, so a reboot re-arms it.
+	migrated := &corev1.Node{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, migrated)).To(Succeed())
+	g.Expect(parseBootstrapAnnotationValue(migrated.Annotations[bootstrapAnnotationKey("live-uid")]).BootID).To(Equal("boot-1"))
+	_, fired := c.bootstrapRearmTrigger(rule, migrated)
+	g.Expect(fired).To(BeFalse())
+
+	migrated.Status.NodeInfo.BootID = "boot-2"
+	trigger, fired := c.bootstrapRearmTrigger(rule, migrated)
+	g.Expect(fired).To(BeTrue())
+	g.Expect(trigger).To(Equal(readinessv1alpha1.BootstrapRearmTriggerNodeReboot))
+}
+
+func TestDisableLegacyBootstrapAnnotations(t *testing.T) {
+	g := NewWithT(t)
+
+	node := gpuNode("gpu-node", false)
+	node.Annotations = map[string]string{legacyBootstrapAnnotationKey("live-rule"): "true"}
+
+	c := &RuleReadinessController{}
+	g.Expect(c.nodeHasBootstrapAnnotation(node, liveRule())).To(BeTrue())
+
+	c.DisableLegacyBootstrapAnnotations = true
+	g.Expect(c.nodeHasBootstrapAnnotation(node, liveRule())).To(BeFalse())
+}
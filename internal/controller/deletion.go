@@ -0,0 +1,86 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// successorRetryInterval is how often a deleted rule waiting for its
+// successor rule to be created is reconciled again.
+const successorRetryInterval = 30 * time.Second
+
+// managesSameTaint reports whether two rules manage the same taint key and effect.
+func managesSameTaint(rule, other *readinessv1alpha1.NodeReadinessRule) bool {
+	return rule.Spec.Taint.Key == other.Spec.Taint.Key && rule.Spec.Taint.Effect == other.Spec.Taint.Effect
+}
+
+// handOverTaintsToSuccessor hands the taint of a deleted rule over to its
+// successor rule. The taint is left on the Nodes the successor selects and
+// manages it on, and removed from all others. It returns false, without
+// touching any Node, while the successor does not exist or does not manage
+// the same taint, in which case deletion must wait.
+func (r *RuleReadinessController) handOverTaintsToSuccessor(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeList *corev1.NodeList) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	successorName := rule.Spec.SuccessorRuleName
+
+	successor := &readinessv1alpha1.NodeReadinessRule{}
+	if err := r.Get(ctx, client.ObjectKey{Name: successorName}, successor); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		log.Info("Waiting for successor rule to be created", "rule", rule.Name, "successor", successorName)
+		r.EventRecorder.Eventf(rule, nil, corev1.EventTypeWarning, "SuccessorRuleNotReady", "HandOverTaint",
+			"Waiting for successor rule '%s' to be created before removing the finalizer", successorName)
+		return false, nil
+	}
+	if !successor.DeletionTimestamp.IsZero() || !managesSameTaint(rule, successor) {
+		log.Info("Successor rule cannot take over the taint", "rule", rule.Name, "successor", successorName)
+		r.EventRecorder.Eventf(rule, nil, corev1.EventTypeWarning, "SuccessorRuleNotReady", "HandOverTaint",
+			"Successor rule '%s' is being deleted or does not manage taint '%s:%s'",
+			successorName, rule.Spec.Taint.Key, rule.Spec.Taint.Effect)
+		return false, nil
+	}
+
+	// Only clean up the Nodes the successor does not take over.
+	orphaned := &corev1.NodeList{}
+	handedOver := 0
+	for _, node := range nodeList.Items {
+		if r.ruleAppliesTo(ctx, successor, &node) && r.hasRuleTaint(&node, successor) {
+			handedOver++
+			continue
+		}
+		orphaned.Items = append(orphaned.Items, node)
+	}
+	if err := r.cleanupTaintsForRule(ctx, rule, orphaned); err != nil {
+		return false, err
+	}
+
+	log.Info("Handed taint over to successor rule", "rule", rule.Name, "successor", successorName, "nodes", handedOver)
+	message := fmt.Sprintf("Taint '%s' on %d nodes handed over to successor rule '%s'", rule.Spec.Taint.Key, handedOver, successorName)
+	r.EventRecorder.Eventf(rule, nil, corev1.EventTypeNormal, "TaintHandedOver", "HandOverTaint", "%s", message)
+	return true, nil
+}
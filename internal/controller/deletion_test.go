@@ -0,0 +1,157 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// deletedRule returns a deletion-marked gpu rule with the given deletion policy.
+func deletedRule(policy readinessv1alpha1.DeletionPolicy, successor string) *readinessv1alpha1.NodeReadinessRule {
+	rule := gpuRule()
+	now := metav1.Now()
+	rule.DeletionTimestamp = &now
+	rule.Finalizers = []string{finalizerName}
+	rule.Spec.DeletionPolicy = policy
+	rule.Spec.SuccessorRuleName = successor
+	return rule
+}
+
+// successorRule returns a rule taking over the gpu taint on the nodes matching the labels.
+func successorRule(matchLabels map[string]string) *readinessv1alpha1.NodeReadinessRule {
+	rule := gpuRule()
+	rule.Name = "gpu-ready-v2"
+	rule.Spec.NodeSelector.MatchLabels = matchLabels
+	return rule
+}
+
+func TestReconcileDelete_DeletionPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		rule          *readinessv1alpha1.NodeReadinessRule
+		successor     *readinessv1alpha1.NodeReadinessRule
+		wantTainted   []string
+		wantFinalized bool
+		wantEvent     string
+	}{
+		{
+			name:          "Delete removes the taint from every node",
+			rule:          deletedRule("", ""),
+			wantFinalized: true,
+		},
+		{
+			name:          "Retain leaves the taint on every node",
+			rule:          deletedRule(readinessv1alpha1.DeletionPolicyRetain, ""),
+			wantTainted:   []string{"gpu-a", "gpu-b"},
+			wantFinalized: true,
+		},
+		{
+			name:        "OrphanToRule waits for the successor to be created",
+			rule:        deletedRule(readinessv1alpha1.DeletionPolicyOrphanToRule, "gpu-ready-v2"),
+			wantTainted: []string{"gpu-a", "gpu-b"},
+			wantEvent:   "Waiting for successor rule 'gpu-ready-v2'",
+		},
+		{
+			name: "OrphanToRule waits for a successor managing the same taint",
+			rule: deletedRule(readinessv1alpha1.DeletionPolicyOrphanToRule, "gpu-ready-v2"),
+			successor: func() *readinessv1alpha1.NodeReadinessRule {
+				successor := successorRule(map[string]string{"gpu": "true"})
+				successor.Spec.Taint.Effect = corev1.TaintEffectNoExecute
+				return successor
+			}(),
+			wantTainted: []string{"gpu-a", "gpu-b"},
+			wantEvent:   "does not manage taint 'readiness.k8s.io/gpu-ready:NoSchedule'",
+		},
+		{
+			name:          "OrphanToRule hands over the nodes the successor selects",
+			rule:          deletedRule(readinessv1alpha1.DeletionPolicyOrphanToRule, "gpu-ready-v2"),
+			successor:     successorRule(map[string]string{"zone": "a"}),
+			wantTainted:   []string{"gpu-a"},
+			wantFinalized: true,
+			wantEvent:     "on 1 nodes handed over to successor rule 'gpu-ready-v2'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			nodeA := gpuNode("gpu-a", true)
+			nodeA.Labels["zone"] = "a"
+			nodeB := gpuNode("gpu-b", true)
+			nodeB.Labels["zone"] = "b"
+			objects := []client.Object{tt.rule, nodeA, nodeB}
+			if tt.successor != nil {
+				objects = append(objects, tt.successor)
+			}
+			fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(objects...).Build()
+			recorder := events.NewFakeRecorder(10)
+			r := &RuleReconciler{
+				Client: fc,
+				Controller: &RuleReadinessController{
+					Client:        fc,
+					EventRecorder: recorder,
+					ruleCache:     make(map[string]*readinessv1alpha1.NodeReadinessRule),
+				},
+			}
+
+			nodeList := &corev1.NodeList{}
+			g.Expect(fc.List(ctx, nodeList)).To(Succeed())
+			result, err := r.reconcileDelete(ctx, tt.rule, nodeList)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			var tainted []string
+			for _, name := range []string{"gpu-a", "gpu-b"} {
+				node := &corev1.Node{}
+				g.Expect(fc.Get(ctx, client.ObjectKey{Name: name}, node)).To(Succeed())
+				if r.Controller.hasTaintBySpec(node, gpuTaint()) {
+					tainted = append(tainted, name)
+				}
+			}
+			g.Expect(tainted).To(Equal(tt.wantTainted))
+
+			err = fc.Get(ctx, client.ObjectKey{Name: tt.rule.Name}, &readinessv1alpha1.NodeReadinessRule{})
+			if tt.wantFinalized {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				g.Expect(result.RequeueAfter).To(BeZero())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(result.RequeueAfter).To(Equal(successorRetryInterval))
+			}
+
+			if tt.wantEvent != "" {
+				var received []string
+				for len(recorder.Events) > 0 {
+					received = append(received, <-recorder.Events)
+				}
+				g.Expect(received).To(ContainElement(ContainSubstring(tt.wantEvent)))
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/flowcontrol"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/metrics"
+)
+
+// drainRetryInterval is how often a node that is still being drained is re-evaluated.
+const drainRetryInterval = 10 * time.Second
+
+// podNodeNameField selects the Pods bound to a Node.
+const podNodeNameField = "spec.nodeName"
+
+// drainEnabled reports whether the rule has drain configured.
+func drainEnabled(rule *readinessv1alpha1.NodeReadinessRule) bool {
+	return rule.Spec.Drain.AfterSeconds > 0
+}
+
+// drainStartTime returns when the node is due to be drained, based on when
+// the rule's taint started its escalation ladder on it, which is when it was
+// added unless it was escalated to NoExecute since.
+func drainStartTime(rule *readinessv1alpha1.NodeReadinessRule, nodeName string, taint *corev1.Taint) (time.Time, bool) {
+	start, ok := escalationStart(rule, nodeName, taint)
+	if !ok {
+		return time.Time{}, false
+	}
+	return start.Add(time.Duration(rule.Spec.Drain.AfterSeconds) * time.Second), true
+}
+
+// isDrainablePod reports whether the pod should be evicted from a node
+// carrying the taint. DaemonSet and mirror pods, pods that have finished or
+// are already terminating, and pods tolerating the taint are left alone.
+func isDrainablePod(ctx context.Context, pod *corev1.Pod, taint *corev1.Taint) bool {
+	if _, isMirror := pod.Annotations[corev1.MirrorPodAnnotationKey]; isMirror {
+		return false
+	}
+	if owner := metav1.GetControllerOf(pod); owner != nil && owner.Kind == "DaemonSet" {
+		return false
+	}
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if !pod.DeletionTimestamp.IsZero() {
+		return false
+	}
+	for i := range pod.Spec.Tolerations {
+		if pod.Spec.Tolerations[i].ToleratesTaint(ctrl.LoggerFrom(ctx), taint, true) {
+			return false
+		}
+	}
+	return true
+}
+
+// evictionLimiter returns the rule's eviction rate limiter, shared across all
+// nodes, creating it if the rule is new or its rate has changed.
+func (r *RuleReadinessController) evictionLimiter(rule *readinessv1alpha1.NodeReadinessRule) flowcontrol.RateLimiter {
+	r.evictionLimitersMutex.Lock()
+	defer r.evictionLimitersMutex.Unlock()
+
+	perMinute := rule.Spec.Drain.GetMaxEvictionsPerMinute()
+	qps := float32(perMinute) / 60
+	if limiter, ok := r.evictionLimiters[rule.Name]; ok && limiter.QPS() == qps {
+		return limiter
+	}
+
+	if r.evictionLimiters == nil {
+		r.evictionLimiters = make(map[string]flowcontrol.RateLimiter)
+	}
+	limiter := flowcontrol.NewTokenBucketRateLimiter(qps, int(perMinute))
+	r.evictionLimiters[rule.Name] = limiter
+	return limiter
+}
+
+// removeEvictionLimiter forgets the eviction rate limiter of a deleted rule.
+func (r *RuleReadinessController) removeEvictionLimiter(ruleName string) {
+	r.evictionLimitersMutex.Lock()
+	defer r.evictionLimitersMutex.Unlock()
+
+	delete(r.evictionLimiters, ruleName)
+}
+
+// drainNode evicts the pods that do not tolerate the rule's taint from the
+// node once the taint has been present for the rule's drain delay. Pods are
+// evicted through the Eviction API, so PodDisruptionBudgets are respected,
+// and at the rule's rate limit. It returns the node's updated drain status,
+// which is empty while the drain is not due yet.
+func (r *RuleReadinessController) drainNode(
+	ctx context.Context,
+	node *corev1.Node,
+	rule *readinessv1alpha1.NodeReadinessRule,
+	previous *readinessv1alpha1.NodeEvaluation,
+) (readinessv1alpha1.NodeDrainStatus, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	taint := findRuleTaint(node, rule)
+	if taint == nil {
+		return readinessv1alpha1.NodeDrainStatus{}, nil
+	}
+	if start, ok := drainStartTime(rule, node.Name, taint); !ok || time.Now().Before(start) {
+		return readinessv1alpha1.NodeDrainStatus{}, nil
+	}
+
+	var status readinessv1alpha1.NodeDrainStatus
+	if previous != nil {
+		status = *previous.Drain.DeepCopy()
+	}
+	if status.Phase == "" {
+		log.Info("Draining node", "node", node.Name, "rule", rule.Name)
+		r.EventRecorder.Eventf(node, nil, corev1.EventTypeWarning, "DrainStarted", "DrainNode",
+			"Draining node after taint '%s' has been present for %ds", rule.Spec.Taint.Key, rule.Spec.Drain.AfterSeconds)
+		status = readinessv1alpha1.NodeDrainStatus{StartTime: metav1.Now()}
+	}
+
+	// The pods are listed from the API server rather than from a cache, which
+	// would hold every pod of the cluster for the few nodes being drained.
+	pods := &corev1.PodList{}
+	if err := r.apiReader.List(ctx, pods, client.MatchingFields{podNodeNameField: node.Name}); err != nil {
+		return status, fmt.Errorf("failed to list pods on node %s: %w", node.Name, err)
+	}
+
+	limiter := r.evictionLimiter(rule)
+	var evicted, remaining, blocked int32
+	var errs []error
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !isDrainablePod(ctx, pod, taint) {
+			continue
+		}
+		remaining++
+		if !limiter.TryAccept() {
+			continue
+		}
+
+		err := r.clientset.PolicyV1().Evictions(pod.Namespace).Evict(ctx, &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+		})
+		switch {
+		case err == nil:
+			log.Info("Evicted pod", "node", node.Name, "rule", rule.Name, "pod", pod.Namespace+"/"+pod.Name)
+			metrics.PodsEvicted.WithLabelValues(rule.Name).Inc()
+			evicted++
+			remaining--
+		case apierrors.IsNotFound(err):
+			// The cache is lagging behind a pod that is already gone.
+			log.V(1).Info("Pod already gone", "node", node.Name, "rule", rule.Name, "pod", pod.Namespace+"/"+pod.Name)
+			remaining--
+		case apierrors.IsTooManyRequests(err):
+			// The eviction would violate a PodDisruptionBudget; retry on the next pass.
+			log.V(1).Info("Eviction refused", "node", node.Name, "rule", rule.Name,
+				"pod", pod.Namespace+"/"+pod.Name, "reason", err.Error())
+			blocked++
+		default:
+			errs = append(errs, fmt.Errorf("failed to evict pod %s/%s: %w", pod.Namespace, pod.Name, err))
+		}
+	}
+
+	podsEvicted := evicted
+	if status.PodsEvicted != nil {
+		podsEvicted += *status.PodsEvicted
+	}
+	status.PodsEvicted = &podsEvicted
+	status.PodsRemaining = &remaining
+
+	previousPhase := status.Phase
+	switch {
+	case remaining == 0:
+		status.Phase = readinessv1alpha1.DrainPhaseCompleted
+		status.Message = ""
+	case blocked > 0:
+		status.Phase = readinessv1alpha1.DrainPhaseBlocked
+		status.Message = fmt.Sprintf("%d pods could not be evicted without violating a PodDisruptionBudget", blocked)
+	default:
+		status.Phase = readinessv1alpha1.DrainPhaseDraining
+		status.Message = ""
+	}
+
+	if status.Phase == readinessv1alpha1.DrainPhaseCompleted && previousPhase != readinessv1alpha1.DrainPhaseCompleted {
+		log.Info("Drained node", "node", node.Name, "rule", rule.Name, "podsEvicted", podsEvicted)
+		r.EventRecorder.Eventf(node, nil, corev1.EventTypeNormal, "DrainCompleted", "DrainNode",
+			"Drained node for rule '%s', %d pods evicted", rule.Name, podsEvicted)
+	}
+
+	return status, errors.Join(errs...)
+}
+
+// drainRequeueAfter returns how long until the node needs to be re-evaluated
+// for its drain to start or make progress.
+func drainRequeueAfter(rule *readinessv1alpha1.NodeReadinessRule, node *corev1.Node, now time.Time) (time.Duration, bool) {
+	if !drainEnabled(rule) {
+		return 0, false
+	}
+	taint := findRuleTaint(node, rule)
+	if taint == nil {
+		return 0, false
+	}
+	start, ok := drainStartTime(rule, node.Name, taint)
+	if !ok {
+		return 0, false
+	}
+	if start.After(now) {
+		return start.Sub(now), true
+	}
+	for _, eval := range rule.Status.NodeEvaluations {
+		if eval.NodeName == node.Name && eval.Drain.Phase == readinessv1alpha1.DrainPhaseCompleted {
+			return 0, false
+		}
+	}
+	return drainRetryInterval, true
+}
@@ -0,0 +1,324 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// drainingRule returns a continuous rule that drains nodes after 10 minutes.
+func drainingRule() *readinessv1alpha1.NodeReadinessRule {
+	rule := gpuRule()
+	rule.Spec.EnforcementMode = readinessv1alpha1.EnforcementModeContinuous
+	rule.Spec.Conditions = []readinessv1alpha1.ConditionRequirement{
+		{Type: "GPUReady", RequiredStatus: corev1.ConditionTrue},
+	}
+	rule.Spec.Drain = readinessv1alpha1.Drain{AfterSeconds: 600, MaxEvictionsPerMinute: 100}
+	return rule
+}
+
+// drainingNode returns a failing node whose rule taint was added the given duration ago.
+func drainingNode(age time.Duration) *corev1.Node {
+	node := gpuNode("gpu-node", true)
+	node.Spec.Taints[0].TimeAdded = &metav1.Time{Time: time.Now().Add(-age)}
+	node.Status.Conditions = []corev1.NodeCondition{{Type: "GPUReady", Status: corev1.ConditionFalse}}
+	return node
+}
+
+func testPod(name string, mutate ...func(*corev1.Pod)) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       corev1.PodSpec{NodeName: "gpu-node"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	for _, m := range mutate {
+		m(pod)
+	}
+	return pod
+}
+
+// newEvictingClientset returns a fake clientset that deletes evicted pods,
+// refusing evictions of the given pods as a PodDisruptionBudget would.
+func newEvictingClientset(pods []runtime.Object, refused ...string) *fake.Clientset {
+	clientset := fake.NewClientset(pods...)
+	podsResource := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		create, ok := action.(k8stesting.CreateAction)
+		if !ok || action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		name := create.GetObject().(metav1.Object).GetName()
+		for _, r := range refused {
+			if r == name {
+				return true, nil, apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10)
+			}
+		}
+		return true, nil, clientset.Tracker().Delete(podsResource, action.GetNamespace(), name)
+	})
+	return clientset
+}
+
+func TestIsDrainablePod(t *testing.T) {
+	ctx := context.Background()
+	taint := gpuTaint()
+
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want bool
+	}{
+		{name: "regular pod", pod: testPod("app"), want: true},
+		{
+			name: "mirror pod",
+			pod: testPod("static", func(p *corev1.Pod) {
+				p.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "hash"}
+			}),
+		},
+		{
+			name: "daemonset pod",
+			pod: testPod("ds", func(p *corev1.Pod) {
+				isController := true
+				p.OwnerReferences = []metav1.OwnerReference{{
+					APIVersion: "apps/v1", Kind: "DaemonSet", Name: "agent", UID: "uid", Controller: &isController,
+				}}
+			}),
+		},
+		{name: "succeeded pod", pod: testPod("done", func(p *corev1.Pod) { p.Status.Phase = corev1.PodSucceeded })},
+		{
+			name: "terminating pod",
+			pod: testPod("terminating", func(p *corev1.Pod) {
+				p.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			}),
+		},
+		{
+			name: "pod tolerating the taint",
+			pod: testPod("tolerant", func(p *corev1.Pod) {
+				p.Spec.Tolerations = []corev1.Toleration{{Key: taint.Key, Operator: corev1.TolerationOpExists}}
+			}),
+		},
+		{
+			name: "pod tolerating another taint",
+			pod: testPod("other", func(p *corev1.Pod) {
+				p.Spec.Tolerations = []corev1.Toleration{{Key: "readiness.k8s.io/other", Operator: corev1.TolerationOpExists}}
+			}),
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(isDrainablePod(ctx, tt.pod, &taint)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestDrainRequeueAfter(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+	rule := drainingRule()
+
+	node := drainingNode(0)
+	node.Spec.Taints[0].TimeAdded = &metav1.Time{Time: now.Add(-4 * time.Minute)}
+	d, ok := drainRequeueAfter(rule, node, now)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(d).To(Equal(6 * time.Minute))
+
+	node.Spec.Taints[0].TimeAdded = &metav1.Time{Time: now.Add(-time.Hour)}
+	d, ok = drainRequeueAfter(rule, node, now)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(d).To(Equal(drainRetryInterval))
+
+	rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{{
+		NodeName: node.Name,
+		Drain:    readinessv1alpha1.NodeDrainStatus{Phase: readinessv1alpha1.DrainPhaseCompleted},
+	}}
+	_, ok = drainRequeueAfter(rule, node, now)
+	g.Expect(ok).To(BeFalse())
+
+	rule.Spec.Drain = readinessv1alpha1.Drain{}
+	_, ok = drainRequeueAfter(rule, node, now)
+	g.Expect(ok).To(BeFalse())
+}
+
+// podNodeName indexes the pods of the fake client by podNodeNameField, which
+// the API server supports as a field selector.
+func podNodeName(obj client.Object) []string {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || pod.Spec.NodeName == "" {
+		return nil
+	}
+	return []string{pod.Spec.NodeName}
+}
+
+func TestEvaluateRuleForNode_Drain(t *testing.T) {
+	// newController seeds the pods listed from the API server with the
+	// clientset's pods, and the given pods deleted before they are evicted.
+	newController := func(t *testing.T, node *corev1.Node, clientset *fake.Clientset, stale ...client.Object) *RuleReadinessController {
+		pods, err := clientset.CoreV1().Pods(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		clientset.ClearActions()
+		builder := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).
+			WithIndex(&corev1.Pod{}, podNodeNameField, podNodeName).
+			WithObjects(node).
+			WithObjects(stale...)
+		for i := range pods.Items {
+			builder = builder.WithObjects(&pods.Items[i])
+		}
+		fc := builder.Build()
+		return &RuleReadinessController{Client: fc, clientset: clientset, apiReader: fc, EventRecorder: events.NewFakeRecorder(10)}
+	}
+
+	t.Run("node is not drained before the delay", func(t *testing.T) {
+		g := NewWithT(t)
+		rule := drainingRule()
+		node := drainingNode(time.Minute)
+		clientset := newEvictingClientset([]runtime.Object{testPod("app")})
+		c := newController(t, node, clientset)
+
+		g.Expect(c.evaluateRuleForNode(context.Background(), rule, node)).To(Succeed())
+
+		g.Expect(c.getPreviousNodeEvaluation(rule, node.Name).Drain.Phase).To(BeEmpty())
+		g.Expect(clientset.Actions()).To(BeEmpty())
+	})
+
+	t.Run("non-tolerating pods are evicted", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := context.Background()
+		rule := drainingRule()
+		node := drainingNode(time.Hour)
+		clientset := newEvictingClientset([]runtime.Object{
+			testPod("app-1"),
+			testPod("app-2"),
+			testPod("static", func(p *corev1.Pod) {
+				p.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "hash"}
+			}),
+		})
+		c := newController(t, node, clientset)
+
+		g.Expect(c.evaluateRuleForNode(ctx, rule, node)).To(Succeed())
+
+		drain := c.getPreviousNodeEvaluation(rule, node.Name).Drain
+		g.Expect(drain.Phase).To(Equal(readinessv1alpha1.DrainPhaseCompleted))
+		g.Expect(*drain.PodsEvicted).To(BeEquivalentTo(2))
+		g.Expect(*drain.PodsRemaining).To(BeEquivalentTo(0))
+
+		pods, err := clientset.CoreV1().Pods("default").List(ctx, metav1.ListOptions{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(pods.Items).To(HaveLen(1))
+		g.Expect(pods.Items[0].Name).To(Equal("static"))
+	})
+
+	t.Run("evictions refused by a PodDisruptionBudget block the drain", func(t *testing.T) {
+		g := NewWithT(t)
+		rule := drainingRule()
+		node := drainingNode(time.Hour)
+		clientset := newEvictingClientset([]runtime.Object{testPod("app"), testPod("protected")}, "protected")
+		c := newController(t, node, clientset)
+
+		g.Expect(c.evaluateRuleForNode(context.Background(), rule, node)).To(Succeed())
+
+		drain := c.getPreviousNodeEvaluation(rule, node.Name).Drain
+		g.Expect(drain.Phase).To(Equal(readinessv1alpha1.DrainPhaseBlocked))
+		g.Expect(*drain.PodsEvicted).To(BeEquivalentTo(1))
+		g.Expect(*drain.PodsRemaining).To(BeEquivalentTo(1))
+		g.Expect(drain.Message).To(ContainSubstring("PodDisruptionBudget"))
+	})
+
+	t.Run("pods already gone are not counted as evicted", func(t *testing.T) {
+		g := NewWithT(t)
+		rule := drainingRule()
+		node := drainingNode(time.Hour)
+		clientset := newEvictingClientset([]runtime.Object{testPod("app")})
+		c := newController(t, node, clientset, testPod("gone"))
+
+		g.Expect(c.evaluateRuleForNode(context.Background(), rule, node)).To(Succeed())
+
+		drain := c.getPreviousNodeEvaluation(rule, node.Name).Drain
+		g.Expect(drain.Phase).To(Equal(readinessv1alpha1.DrainPhaseCompleted))
+		g.Expect(*drain.PodsEvicted).To(BeEquivalentTo(1))
+		g.Expect(*drain.PodsRemaining).To(BeEquivalentTo(0))
+	})
+
+	t.Run("pods of other nodes are left alone", func(t *testing.T) {
+		g := NewWithT(t)
+		rule := drainingRule()
+		node := drainingNode(time.Hour)
+		clientset := newEvictingClientset([]runtime.Object{
+			testPod("app"),
+			testPod("elsewhere", func(p *corev1.Pod) { p.Spec.NodeName = "other-node" }),
+		})
+		c := newController(t, node, clientset)
+
+		g.Expect(c.evaluateRuleForNode(context.Background(), rule, node)).To(Succeed())
+
+		drain := c.getPreviousNodeEvaluation(rule, node.Name).Drain
+		g.Expect(*drain.PodsEvicted).To(BeEquivalentTo(1))
+		_, err := clientset.CoreV1().Pods("default").Get(context.Background(), "elsewhere", metav1.GetOptions{})
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("evictions are rate limited", func(t *testing.T) {
+		g := NewWithT(t)
+		rule := drainingRule()
+		rule.Spec.Drain.MaxEvictionsPerMinute = 1
+		node := drainingNode(time.Hour)
+		clientset := newEvictingClientset([]runtime.Object{testPod("app-1"), testPod("app-2"), testPod("app-3")})
+		c := newController(t, node, clientset)
+
+		g.Expect(c.evaluateRuleForNode(context.Background(), rule, node)).To(Succeed())
+
+		drain := c.getPreviousNodeEvaluation(rule, node.Name).Drain
+		g.Expect(drain.Phase).To(Equal(readinessv1alpha1.DrainPhaseDraining))
+		g.Expect(*drain.PodsEvicted).To(BeEquivalentTo(1))
+		g.Expect(*drain.PodsRemaining).To(BeEquivalentTo(2))
+	})
+
+	t.Run("drain status is cleared once the node recovers", func(t *testing.T) {
+		g := NewWithT(t)
+		rule := drainingRule()
+		node := drainingNode(time.Hour)
+		node.Status.Conditions[0].Status = corev1.ConditionTrue
+		rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{{
+			NodeName: node.Name,
+			Drain:    readinessv1alpha1.NodeDrainStatus{Phase: readinessv1alpha1.DrainPhaseCompleted},
+		}}
+		c := newController(t, node, newEvictingClientset(nil))
+
+		g.Expect(c.evaluateRuleForNode(context.Background(), rule, node)).To(Succeed())
+
+		g.Expect(c.getPreviousNodeEvaluation(rule, node.Name).Drain.Phase).To(BeEmpty())
+	})
+}
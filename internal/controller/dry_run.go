@@ -0,0 +1,440 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// maxDryRunNodes is the number of nodes listed in a rule's dry run results,
+// matching the MaxItems of DryRunResults.Nodes.
+const maxDryRunNodes = 100
+
+// nodeDryRun is the dry run outcome of a rule for a Node it selects.
+type nodeDryRun struct {
+	// outOfScope is set for Nodes outside the nodeScope of bootstrap-only rules.
+	outOfScope bool
+	// change is the change the rule would make to the Node's taint, or "".
+	change readinessv1alpha1.DryRunAction
+	// failing and missing are the rule's conditions the Node does not meet
+	// and does not report.
+	failing, missing []string
+}
+
+// dryRunNode returns how the outcome is listed in the rule's dry run results,
+// if at all.
+func (d nodeDryRun) dryRunNode(nodeName string) (readinessv1alpha1.DryRunNode, bool) {
+	action := d.change
+	if len(d.missing) > 0 {
+		action = readinessv1alpha1.DryRunActionRiskyMissingCondition
+	}
+	if action == "" {
+		return readinessv1alpha1.DryRunNode{}, false
+	}
+	return readinessv1alpha1.DryRunNode{
+		NodeName:          nodeName,
+		Action:            action,
+		FailingConditions: d.failing,
+		MissingConditions: d.missing,
+	}, true
+}
+
+// processDryRun processes dry run for a rule.
+//
+//nolint:unparam // Keep error return for future extensibility and API stability.
+func (r *RuleReadinessController) processDryRun(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeList *corev1.NodeList) error {
+	now := time.Now()
+	plan := make(map[string]nodeDryRun)
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if r.ruleAppliesTo(ctx, rule, node) {
+			plan[node.Name] = r.planNodeDryRun(rule, node, now)
+		}
+	}
+	r.setDryRunPlan(rule.Name, plan)
+
+	// Update rule status with dry run results
+	rule.Status.ObservedGeneration = rule.Generation
+	rule.Status.DryRunResults = dryRunResults(plan)
+	return nil
+}
+
+// trackDryRunStart records when the rule's current spec started running in
+// dry run mode, restarting the count when the spec changes, and forgets it
+// once the rule is enforced.
+func trackDryRunStart(rule *readinessv1alpha1.NodeReadinessRule, now time.Time) {
+	switch {
+	case !rule.Spec.DryRun:
+		rule.Status.DryRunStartTime = metav1.Time{}
+	case rule.Status.DryRunStartTime.IsZero() || rule.Status.ObservedGeneration != rule.Generation:
+		rule.Status.DryRunStartTime = metav1.NewTime(now)
+	}
+}
+
+// planNodeDryRun simulates the evaluation of the rule for the node.
+func (r *RuleReadinessController) planNodeDryRun(rule *readinessv1alpha1.NodeReadinessRule, node *corev1.Node, now time.Time) nodeDryRun {
+	if rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly && !nodeInNodeScope(rule, node) {
+		return nodeDryRun{outOfScope: true}
+	}
+
+	// Simulate rule evaluation using the rule's conditionPolicy
+	var plan nodeDryRun
+	allSatisfied := true
+	anySatisfied := false
+	for _, condReq := range rule.Spec.Conditions {
+		currentStatus, conditionFound := r.getConditionStatus(node, condReq.Type, condReq.GetDefaultStatus())
+		if !conditionFound {
+			plan.missing = append(plan.missing, condReq.Type)
+		}
+		if currentStatus != condReq.RequiredStatus {
+			allSatisfied = false
+			plan.failing = append(plan.failing, condReq.Type)
+		} else {
+			anySatisfied = true
+		}
+	}
+
+	shouldRemoveTaint := allSatisfied
+	if rule.Spec.GetConditionPolicy() == readinessv1alpha1.ConditionPolicyAnyOf {
+		shouldRemoveTaint = anySatisfied
+	}
+
+	// A quarantined node would keep its taint until the quarantine is lifted.
+	previous := r.getPreviousNodeEvaluation(rule, node.Name)
+	if flapDetectionEnabled(rule) && previous != nil && isQuarantined(previous.Flap) && !hasClearQuarantineAnnotation(node) {
+		shouldRemoveTaint = false
+	}
+
+	// Honour operator overrides; exempt nodes would be left untouched.
+	override, _, _ := resolveNodeOverride(node, rule.Name, now)
+	switch override.Action {
+	case readinessv1alpha1.OverrideActionForceHold:
+		shouldRemoveTaint = false
+	case readinessv1alpha1.OverrideActionForceRelease:
+		shouldRemoveTaint = true
+	case readinessv1alpha1.OverrideActionExempt:
+		return nodeDryRun{}
+	}
+
+	// A taint the node carried before the rule first evaluated it is adopted
+	// according to the rule's taint adoption policy.
+	currentTaint := findRuleTaint(node, rule)
+	adopting := currentTaint != nil && previous == nil
+	switch {
+	case adopting && rule.Spec.GetTaintAdoptionPolicy() == readinessv1alpha1.TaintAdoptionPolicyRefuse:
+		// Refused nodes would be left untouched.
+	case !shouldRemoveTaint && currentTaint == nil &&
+		rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly && r.nodeHasBootstrapAnnotation(node, rule):
+		// Nodes that completed bootstrap would not be tainted again.
+	case shouldRemoveTaint && currentTaint != nil:
+		plan.change = readinessv1alpha1.DryRunActionRemoveTaint
+	case !shouldRemoveTaint && currentTaint == nil:
+		plan.change = readinessv1alpha1.DryRunActionAddTaint
+	case !shouldRemoveTaint && taintEscalationDue(rule, node.Name, currentTaint, now):
+		plan.change = readinessv1alpha1.DryRunActionEscalateTaint
+	case adopting:
+		plan.change = readinessv1alpha1.DryRunActionAdoptTaint
+	}
+	return plan
+}
+
+// NodePreview is what a rule would do to a Node it selects.
+type NodePreview struct {
+	// OutOfScope is set for Nodes outside the nodeScope of bootstrap-only rules.
+	OutOfScope bool
+	// AddsTaint is set when the rule would add its taint to the Node.
+	AddsTaint bool
+	// Enforced is set when the Node is in the rule's current rollout subset,
+	// so that the rule would act on it straight away.
+	Enforced bool
+}
+
+// PreviewNode evaluates the rule for the node the way the controller would,
+// without changing anything. The rule's status provides the Node's previous
+// evaluation, if any.
+func (r *RuleReadinessController) PreviewNode(rule *readinessv1alpha1.NodeReadinessRule, node *corev1.Node, now time.Time) NodePreview {
+	plan := r.planNodeDryRun(rule, node, now)
+	return NodePreview{
+		OutOfScope: plan.outOfScope,
+		AddsTaint:  plan.change == readinessv1alpha1.DryRunActionAddTaint,
+		Enforced:   inRolloutSubset(rule, node.Name),
+	}
+}
+
+// dryRunResults summarizes the dry run outcomes of a rule for its Nodes.
+func dryRunResults(plan map[string]nodeDryRun) readinessv1alpha1.DryRunResults {
+	var affectedNodes, outOfScopeNodes, taintsToAdd, taintsToRemove, taintsToEscalate, riskyOps int32
+	var nodes []readinessv1alpha1.DryRunNode
+	for _, nodeName := range slices.Sorted(maps.Keys(plan)) {
+		outcome := plan[nodeName]
+		if outcome.outOfScope {
+			outOfScopeNodes++
+			continue
+		}
+
+		affectedNodes++
+		switch outcome.change {
+		case readinessv1alpha1.DryRunActionAddTaint:
+			taintsToAdd++
+		case readinessv1alpha1.DryRunActionRemoveTaint:
+			taintsToRemove++
+		case readinessv1alpha1.DryRunActionEscalateTaint:
+			taintsToEscalate++
+		}
+		if len(outcome.missing) > 0 {
+			riskyOps++
+		}
+		if node, ok := outcome.dryRunNode(nodeName); ok {
+			nodes = append(nodes, node)
+		}
+	}
+
+	// Build summary
+	var summaryParts []string
+	if taintsToAdd > 0 {
+		summaryParts = append(summaryParts, fmt.Sprintf("would add %d taints", taintsToAdd))
+	}
+	if taintsToRemove > 0 {
+		summaryParts = append(summaryParts, fmt.Sprintf("would remove %d taints", taintsToRemove))
+	}
+	if taintsToEscalate > 0 {
+		summaryParts = append(summaryParts, fmt.Sprintf("would escalate %d taints", taintsToEscalate))
+	}
+	if riskyOps > 0 {
+		summaryParts = append(summaryParts, fmt.Sprintf("%d nodes have missing conditions", riskyOps))
+	}
+	if outOfScopeNodes > 0 {
+		summaryParts = append(summaryParts, fmt.Sprintf("%d nodes are outside the node scope", outOfScopeNodes))
+	}
+	if len(nodes) > maxDryRunNodes {
+		summaryParts = append(summaryParts, fmt.Sprintf("%d of %d nodes listed", maxDryRunNodes, len(nodes)))
+		nodes = nodes[:maxDryRunNodes]
+	}
+
+	summary := "No changes needed"
+	if len(summaryParts) > 0 {
+		summary = strings.Join(summaryParts, ", ")
+	}
+
+	return readinessv1alpha1.DryRunResults{
+		AffectedNodes:    &affectedNodes,
+		OutOfScopeNodes:  &outOfScopeNodes,
+		TaintsToAdd:      &taintsToAdd,
+		TaintsToRemove:   &taintsToRemove,
+		TaintsToEscalate: &taintsToEscalate,
+		RiskyOperations:  &riskyOps,
+		Summary:          summary,
+		Nodes:            nodes,
+	}
+}
+
+// setDryRunPlan records the dry run outcomes of the rule for its Nodes.
+func (r *RuleReadinessController) setDryRunPlan(ruleName string, plan map[string]nodeDryRun) {
+	r.dryRunPlansMutex.Lock()
+	defer r.dryRunPlansMutex.Unlock()
+	if r.dryRunPlans == nil {
+		r.dryRunPlans = make(map[string]map[string]nodeDryRun)
+	}
+	r.dryRunPlans[ruleName] = plan
+}
+
+// deleteDryRunPlan forgets the dry run outcomes of the rule.
+func (r *RuleReadinessController) deleteDryRunPlan(ruleName string) {
+	r.dryRunPlansMutex.Lock()
+	defer r.dryRunPlansMutex.Unlock()
+	delete(r.dryRunPlans, ruleName)
+}
+
+// dryRunResultsOf returns the dry run results of the rule for its Nodes as
+// currently planned, or false if the rule has no dry run.
+func (r *RuleReadinessController) dryRunResultsOf(ruleName string) (readinessv1alpha1.DryRunResults, bool) {
+	r.dryRunPlansMutex.Lock()
+	defer r.dryRunPlansMutex.Unlock()
+	plan, ok := r.dryRunPlans[ruleName]
+	if !ok {
+		return readinessv1alpha1.DryRunResults{}, false
+	}
+	return dryRunResults(plan), true
+}
+
+// updateNodeDryRun plans the node again in the dry run of the rule, or drops
+// it when the rule is enforced on it, and updates the rule's dry run results
+// if the outcome changed. Rules whose dry run was not processed yet are left
+// to the rule reconciler.
+func (r *RuleReadinessController) updateNodeDryRun(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, node *corev1.Node, inDryRun bool) error {
+	var outcome nodeDryRun
+	if inDryRun {
+		outcome = r.planNodeDryRun(rule, node, time.Now())
+	}
+
+	r.dryRunPlansMutex.Lock()
+	plan, ok := r.dryRunPlans[rule.Name]
+	current, planned := plan[node.Name]
+	if !ok || (inDryRun && planned && reflect.DeepEqual(current, outcome)) || (!inDryRun && !planned) {
+		r.dryRunPlansMutex.Unlock()
+		return nil
+	}
+	if inDryRun {
+		plan[node.Name] = outcome
+	} else {
+		delete(plan, node.Name)
+	}
+	r.dryRunPlansMutex.Unlock()
+
+	return r.patchDryRunResults(ctx, rule.Name)
+}
+
+// forgetDryRunNode drops a deleted node from the dry run of every rule.
+func (r *RuleReadinessController) forgetDryRunNode(ctx context.Context, nodeName string) error {
+	var ruleNames []string
+	r.dryRunPlansMutex.Lock()
+	for ruleName, plan := range r.dryRunPlans {
+		if _, ok := plan[nodeName]; ok {
+			delete(plan, nodeName)
+			ruleNames = append(ruleNames, ruleName)
+		}
+	}
+	r.dryRunPlansMutex.Unlock()
+
+	var errs []error
+	for _, ruleName := range ruleNames {
+		if err := r.patchDryRunResults(ctx, ruleName); err != nil {
+			errs = append(errs, fmt.Errorf("rule %s: %w", ruleName, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// patchDryRunResults records the rule's current dry run results in its
+// status. The results are computed after the rule is read and written with an
+// optimistic lock, so that a concurrent update of the plan, whose results were
+// computed earlier, cannot overwrite them with stale ones: either it was
+// written before the rule was read, or it makes this patch conflict and the
+// results are computed again.
+func (r *RuleReadinessController) patchDryRunResults(ctx context.Context, ruleName string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestRule := &readinessv1alpha1.NodeReadinessRule{}
+		if err := r.Get(ctx, client.ObjectKey{Name: ruleName}, latestRule); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		results, ok := r.dryRunResultsOf(ruleName)
+		if !ok {
+			return nil
+		}
+		patch := client.MergeFromWithOptions(latestRule.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		latestRule.Status.DryRunResults = results
+		return r.Status().Patch(ctx, latestRule, patch)
+	})
+}
+
+// ruleDryRun is the dry run of a rule served by DryRunHandler.
+type ruleDryRun struct {
+	Rule  string                         `json:"rule"`
+	Nodes []readinessv1alpha1.DryRunNode `json:"nodes"`
+}
+
+// DryRunHandler serves, as JSON, every node that rules in dry run mode would
+// change or whose required conditions are missing, without the limit of the
+// rules' status. The rule query parameter restricts the response to a rule.
+//
+// The dry runs are only kept in memory by the leader, which evaluates the
+// rules. Other replicas, and the leader until it has processed the dry run of
+// a requested rule, respond with 503 Service Unavailable. The elected channel
+// is closed once the replica is the leader.
+func (r *RuleReadinessController) DryRunHandler(elected <-chan struct{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-elected:
+		default:
+			w.Header().Set("Retry-After", "10")
+			http.Error(w, "dry run results are only served by the leader", http.StatusServiceUnavailable)
+			return
+		}
+		ruleName := req.URL.Query().Get("rule")
+
+		r.dryRunPlansMutex.Lock()
+		var dryRuns []ruleDryRun
+		for _, name := range slices.Sorted(maps.Keys(r.dryRunPlans)) {
+			if ruleName != "" && name != ruleName {
+				continue
+			}
+			dryRun := ruleDryRun{Rule: name, Nodes: []readinessv1alpha1.DryRunNode{}}
+			plan := r.dryRunPlans[name]
+			for _, nodeName := range slices.Sorted(maps.Keys(plan)) {
+				if node, ok := plan[nodeName].dryRunNode(nodeName); ok {
+					dryRun.Nodes = append(dryRun.Nodes, node)
+				}
+			}
+			dryRuns = append(dryRuns, dryRun)
+		}
+		r.dryRunPlansMutex.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		var body any = dryRuns
+		if ruleName != "" {
+			if len(dryRuns) == 0 {
+				r.serveMissingDryRun(w, req, ruleName)
+				return
+			}
+			body = dryRuns[0]
+		} else if dryRuns == nil {
+			body = []ruleDryRun{}
+		}
+		if err := json.NewEncoder(w).Encode(body); err != nil {
+			ctrl.LoggerFrom(req.Context()).Error(err, "Failed to write dry run response")
+		}
+	})
+}
+
+// serveMissingDryRun responds to a request for the dry run of a rule that has
+// none in memory: 503 Service Unavailable if the rule has a dry run that was
+// not processed yet, and 404 Not Found otherwise.
+func (r *RuleReadinessController) serveMissingDryRun(w http.ResponseWriter, req *http.Request, ruleName string) {
+	rule := &readinessv1alpha1.NodeReadinessRule{}
+	if err := r.Get(req.Context(), client.ObjectKey{Name: ruleName}, rule); err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, fmt.Sprintf("rule %q not found", ruleName), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to get rule %q: %v", ruleName, err), http.StatusInternalServerError)
+		return
+	}
+	if !rule.Spec.DryRun && !hasRollout(rule) {
+		http.Error(w, fmt.Sprintf("rule %q is not in dry run", ruleName), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Retry-After", "10")
+	http.Error(w, fmt.Sprintf("dry run of rule %q is not populated yet", ruleName), http.StatusServiceUnavailable)
+}
@@ -0,0 +1,266 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// dryRunRule returns the gpu rule in dry run mode, requiring gpu-ready=True.
+func dryRunRule() *readinessv1alpha1.NodeReadinessRule {
+	rule := gpuRule()
+	rule.Spec.DryRun = true
+	rule.Spec.Conditions = []readinessv1alpha1.ConditionRequirement{
+		{Type: "gpu-ready", RequiredStatus: corev1.ConditionTrue},
+	}
+	return rule
+}
+
+// gpuReadyNode returns a gpu node reporting gpu-ready with the given status,
+// or not reporting it when status is empty.
+func gpuReadyNode(name string, tainted bool, status corev1.ConditionStatus) *corev1.Node {
+	node := gpuNode(name, tainted)
+	if status != "" {
+		node.Status.Conditions = []corev1.NodeCondition{{Type: "gpu-ready", Status: status}}
+	}
+	return node
+}
+
+func TestProcessDryRun_ListsNodes(t *testing.T) {
+	g := NewWithT(t)
+	rule := dryRunRule()
+	nodeList := &corev1.NodeList{Items: []corev1.Node{
+		*gpuReadyNode("node-add", false, corev1.ConditionFalse),
+		*gpuReadyNode("node-adopt", true, corev1.ConditionFalse),
+		*gpuReadyNode("node-missing", false, ""),
+		*gpuReadyNode("node-ready", false, corev1.ConditionTrue),
+		*gpuReadyNode("node-remove", true, corev1.ConditionTrue),
+	}}
+	r := &RuleReadinessController{}
+
+	g.Expect(r.processDryRun(t.Context(), rule, nodeList)).To(Succeed())
+	results := rule.Status.DryRunResults
+	g.Expect(results.AffectedNodes).To(Equal(ptr.To[int32](5)))
+	g.Expect(results.TaintsToAdd).To(Equal(ptr.To[int32](2)))
+	g.Expect(results.TaintsToRemove).To(Equal(ptr.To[int32](1)))
+	g.Expect(results.RiskyOperations).To(Equal(ptr.To[int32](1)))
+	g.Expect(results.Nodes).To(Equal([]readinessv1alpha1.DryRunNode{
+		{NodeName: "node-add", Action: readinessv1alpha1.DryRunActionAddTaint, FailingConditions: []string{"gpu-ready"}},
+		{NodeName: "node-adopt", Action: readinessv1alpha1.DryRunActionAdoptTaint, FailingConditions: []string{"gpu-ready"}},
+		{
+			NodeName: "node-missing", Action: readinessv1alpha1.DryRunActionRiskyMissingCondition,
+			FailingConditions: []string{"gpu-ready"}, MissingConditions: []string{"gpu-ready"},
+		},
+		{NodeName: "node-remove", Action: readinessv1alpha1.DryRunActionRemoveTaint},
+	}))
+
+	// Nodes whose pre-existing taint the rule refuses are left untouched.
+	rule.Spec.TaintAdoptionPolicy = readinessv1alpha1.TaintAdoptionPolicyRefuse
+	g.Expect(r.processDryRun(t.Context(), rule, nodeList)).To(Succeed())
+	g.Expect(rule.Status.DryRunResults.TaintsToRemove).To(Equal(ptr.To[int32](0)))
+	g.Expect(rule.Status.DryRunResults.Nodes).To(HaveLen(2))
+}
+
+func TestPlanNodeDryRun_BootstrapAndQuarantine(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+	r := &RuleReadinessController{}
+
+	// Nodes that completed bootstrap would not be tainted again.
+	rule := dryRunRule()
+	rule.UID = "gpu-rule-uid"
+	rule.Spec.EnforcementMode = readinessv1alpha1.EnforcementModeBootstrapOnly
+	node := gpuReadyNode("node-1", false, corev1.ConditionFalse)
+	g.Expect(r.planNodeDryRun(rule, node, now).change).To(Equal(readinessv1alpha1.DryRunActionAddTaint))
+	node.Annotations = map[string]string{bootstrapAnnotationKey(rule.UID): "{}"}
+	g.Expect(r.planNodeDryRun(rule, node, now).change).To(BeEmpty())
+
+	// Quarantined nodes would keep their taint until the quarantine is lifted.
+	rule = dryRunRule()
+	rule.Spec.FlapDetection = readinessv1alpha1.FlapDetection{TransitionThreshold: 2, WindowSeconds: 60, CooldownSeconds: 300}
+	rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{
+		{NodeName: "node-2", Flap: readinessv1alpha1.FlapState{QuarantineStartTime: metav1.NewTime(now)}},
+	}
+	node = gpuReadyNode("node-2", true, corev1.ConditionTrue)
+	g.Expect(r.planNodeDryRun(rule, node, now).change).To(BeEmpty())
+	node.Annotations = map[string]string{clearQuarantineAnnotationKey: ""}
+	g.Expect(r.planNodeDryRun(rule, node, now).change).To(Equal(readinessv1alpha1.DryRunActionRemoveTaint))
+}
+
+func TestDryRunResults_BoundsNodes(t *testing.T) {
+	g := NewWithT(t)
+	plan := make(map[string]nodeDryRun)
+	for i := range maxDryRunNodes + 20 {
+		plan[fmt.Sprintf("node-%03d", i)] = nodeDryRun{change: readinessv1alpha1.DryRunActionAddTaint}
+	}
+
+	results := dryRunResults(plan)
+	g.Expect(results.TaintsToAdd).To(Equal(ptr.To[int32](maxDryRunNodes + 20)))
+	g.Expect(results.Nodes).To(HaveLen(maxDryRunNodes))
+	g.Expect(results.Nodes[0].NodeName).To(Equal("node-000"))
+	g.Expect(results.Summary).To(ContainSubstring("100 of 120 nodes listed"))
+}
+
+func TestTrackDryRunStart(t *testing.T) {
+	g := NewWithT(t)
+	rule := dryRunRule()
+	rule.Generation = 1
+	start := time.Now().Truncate(time.Second)
+
+	trackDryRunStart(rule, start)
+	g.Expect(rule.Status.DryRunStartTime.Time).To(Equal(start))
+
+	// The start is kept while the spec is unchanged.
+	rule.Status.ObservedGeneration = 1
+	trackDryRunStart(rule, start.Add(time.Hour))
+	g.Expect(rule.Status.DryRunStartTime.Time).To(Equal(start))
+
+	// A spec change restarts the count.
+	rule.Generation = 2
+	trackDryRunStart(rule, start.Add(2*time.Hour))
+	g.Expect(rule.Status.DryRunStartTime.Time).To(Equal(start.Add(2 * time.Hour)))
+
+	// Enforced rules do not track it.
+	rule.Spec.DryRun = false
+	trackDryRunStart(rule, start.Add(3*time.Hour))
+	g.Expect(rule.Status.DryRunStartTime).To(Equal(metav1.Time{}))
+}
+
+func TestUpdateNodeDryRun(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	rule := dryRunRule()
+	node := gpuReadyNode("node-1", false, corev1.ConditionFalse)
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).
+		WithObjects(rule, node).WithStatusSubresource(rule).Build()
+	r := &RuleReadinessController{Client: fc}
+
+	// Rules whose dry run was not processed yet are left to the rule reconciler.
+	g.Expect(r.updateNodeDryRun(ctx, rule, node, true)).To(Succeed())
+	latest := &readinessv1alpha1.NodeReadinessRule{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: rule.Name}, latest)).To(Succeed())
+	g.Expect(latest.Status.DryRunResults.Summary).To(BeEmpty())
+
+	g.Expect(r.processDryRun(ctx, rule, &corev1.NodeList{Items: []corev1.Node{*node}})).To(Succeed())
+	g.Expect(rule.Status.DryRunResults.TaintsToAdd).To(Equal(ptr.To[int32](1)))
+
+	// The node becomes ready.
+	node.Status.Conditions[0].Status = corev1.ConditionTrue
+	g.Expect(r.updateNodeDryRun(ctx, rule, node, true)).To(Succeed())
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: rule.Name}, latest)).To(Succeed())
+	g.Expect(latest.Status.DryRunResults.TaintsToAdd).To(Equal(ptr.To[int32](0)))
+	g.Expect(latest.Status.DryRunResults.Summary).To(Equal("No changes needed"))
+
+	// The node becomes unready, then is deleted.
+	node.Status.Conditions[0].Status = corev1.ConditionFalse
+	g.Expect(r.updateNodeDryRun(ctx, rule, node, true)).To(Succeed())
+	g.Expect(r.forgetDryRunNode(ctx, node.Name)).To(Succeed())
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: rule.Name}, latest)).To(Succeed())
+	g.Expect(latest.Status.DryRunResults.AffectedNodes).To(Equal(ptr.To[int32](0)))
+	g.Expect(latest.Status.DryRunResults.Nodes).To(BeEmpty())
+}
+
+func TestUpdateNodeDryRun_ConcurrentUpdates(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	rule := dryRunRule()
+	node := gpuReadyNode("node-1", false, corev1.ConditionFalse)
+	r := &RuleReadinessController{}
+
+	// The node becomes unready again while the results of it becoming ready
+	// are being written; the results of its latest state win.
+	raced := false
+	r.Client = fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).
+		WithObjects(rule, node).WithStatusSubresource(rule).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				if !raced {
+					raced = true
+					g.Expect(r.updateNodeDryRun(ctx, rule, node, true)).To(Succeed())
+				}
+				return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			},
+		}).Build()
+	g.Expect(r.processDryRun(ctx, rule, &corev1.NodeList{Items: []corev1.Node{*node}})).To(Succeed())
+
+	ready := node.DeepCopy()
+	ready.Status.Conditions[0].Status = corev1.ConditionTrue
+	g.Expect(r.updateNodeDryRun(ctx, rule, ready, true)).To(Succeed())
+	g.Expect(raced).To(BeTrue())
+
+	latest := &readinessv1alpha1.NodeReadinessRule{}
+	g.Expect(r.Get(ctx, client.ObjectKey{Name: rule.Name}, latest)).To(Succeed())
+	g.Expect(latest.Status.DryRunResults.TaintsToAdd).To(Equal(ptr.To[int32](1)))
+}
+
+func TestDryRunHandler(t *testing.T) {
+	g := NewWithT(t)
+	rule := dryRunRule()
+	pending := dryRunRule()
+	pending.Name = "pending"
+	r := &RuleReadinessController{
+		Client: fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(rule, pending).Build(),
+	}
+	g.Expect(r.processDryRun(t.Context(), rule, &corev1.NodeList{Items: []corev1.Node{
+		*gpuReadyNode("node-1", false, corev1.ConditionFalse),
+		*gpuReadyNode("node-2", false, corev1.ConditionTrue),
+	}})).To(Succeed())
+
+	elected := make(chan struct{})
+	handler := r.DryRunHandler(elected)
+
+	// Only the leader serves dry runs.
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/dryrun?rule="+rule.Name, nil))
+	g.Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+	close(elected)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/dryrun?rule="+rule.Name, nil))
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	var dryRun ruleDryRun
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &dryRun)).To(Succeed())
+	g.Expect(dryRun.Rule).To(Equal(rule.Name))
+	g.Expect(dryRun.Nodes).To(HaveLen(1))
+	g.Expect(dryRun.Nodes[0].NodeName).To(Equal("node-1"))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/dryrun?rule=unknown", nil))
+	g.Expect(rec.Code).To(Equal(http.StatusNotFound))
+
+	// A rule in dry run that was not processed yet is not served either.
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/dryrun?rule=pending", nil))
+	g.Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+	g.Expect(rec.Body.String()).To(ContainSubstring("not populated yet"))
+}
@@ -0,0 +1,172 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// findRuleTaint returns the rule's taint on the node, whichever step of the
+// escalation ladder it is at, or nil if the node does not carry it.
+func findRuleTaint(node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule) *corev1.Taint {
+	effects := rule.Spec.GetTaintEffects()
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Key == rule.Spec.Taint.Key && slices.Contains(effects, taint.Effect) {
+			return taint
+		}
+	}
+	return nil
+}
+
+// hasRuleTaint checks if a node carries the rule's taint with any of its effects.
+func (r *RuleReadinessController) hasRuleTaint(node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule) bool {
+	return findRuleTaint(node, rule) != nil
+}
+
+// escalationStart returns when the rule's taint on the node started its
+// escalation ladder: the escalationStartTime recorded in the node's
+// evaluation once the taint's TimeAdded was reset by escalating it to
+// NoExecute, or the taint's TimeAdded otherwise.
+func escalationStart(rule *readinessv1alpha1.NodeReadinessRule, nodeName string, taint *corev1.Taint) (time.Time, bool) {
+	for _, eval := range rule.Status.NodeEvaluations {
+		if eval.NodeName == nodeName && !eval.EscalationStartTime.IsZero() {
+			return eval.EscalationStartTime.Time, true
+		}
+	}
+	if taint.TimeAdded == nil {
+		return time.Time{}, false
+	}
+	return taint.TimeAdded.Time, true
+}
+
+// escalatedEffect returns the effect the rule's taint should have once its
+// escalation ladder started at start.
+func escalatedEffect(rule *readinessv1alpha1.NodeReadinessRule, start, now time.Time) corev1.TaintEffect {
+	effect := rule.Spec.Taint.Effect
+	for _, step := range rule.Spec.TaintEscalation {
+		if now.Sub(start) < time.Duration(step.AfterSeconds)*time.Second {
+			break
+		}
+		effect = step.Effect
+	}
+	return effect
+}
+
+// taintEscalationDue reports whether the rule's taint on the node is due for
+// the next step of its escalation ladder.
+func taintEscalationDue(rule *readinessv1alpha1.NodeReadinessRule, nodeName string, taint *corev1.Taint, now time.Time) bool {
+	start, ok := escalationStart(rule, nodeName, taint)
+	return ok && escalatedEffect(rule, start, now) != taint.Effect
+}
+
+// nextEscalationAfter returns how long until the rule's taint on the node
+// reaches the next step of the escalation ladder.
+func nextEscalationAfter(rule *readinessv1alpha1.NodeReadinessRule, node *corev1.Node, now time.Time) (time.Duration, bool) {
+	taint := findRuleTaint(node, rule)
+	if taint == nil {
+		return 0, false
+	}
+	start, ok := escalationStart(rule, node.Name, taint)
+	if !ok {
+		return 0, false
+	}
+	for _, step := range rule.Spec.TaintEscalation {
+		at := start.Add(time.Duration(step.AfterSeconds) * time.Second)
+		if at.After(now) {
+			return at.Sub(now), true
+		}
+	}
+	return 0, false
+}
+
+// escalateTaint swaps the effect of the rule's taint on the node in place
+// once it is due for the next step of the escalation ladder. A taint without
+// TimeAdded, e.g. one that was adopted, is stamped so that escalation starts
+// from now. The taint manager evicts pods tolerating a NoExecute taint for a
+// bounded time once their toleration has elapsed since its TimeAdded, so a
+// taint escalated to NoExecute is stamped again, and the start of its ladder
+// is returned for the node's evaluation to keep. It returns whether the
+// taint's effect was changed.
+func (r *RuleReadinessController) escalateTaint(ctx context.Context, node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule) (bool, metav1.Time, error) {
+	log := ctrl.LoggerFrom(ctx)
+	escalated := false
+	var from, to corev1.TaintEffect
+	var started metav1.Time
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		escalated = false
+		started = metav1.Time{}
+
+		latestNode := &corev1.Node{}
+		if err := r.Get(ctx, client.ObjectKey{Name: node.Name}, latestNode); err != nil {
+			return err
+		}
+
+		stored := latestNode.DeepCopy()
+		taint := findRuleTaint(latestNode, rule)
+		if taint == nil {
+			return nil
+		}
+
+		now := time.Now()
+		stamped := taint.TimeAdded == nil
+		if stamped {
+			taint.TimeAdded = &metav1.Time{Time: now}
+		}
+		start, _ := escalationStart(rule, node.Name, taint)
+		from, to = taint.Effect, escalatedEffect(rule, start, now)
+		if from == to && !stamped {
+			return nil
+		}
+		taint.Effect = to
+		if from != to && to == corev1.TaintEffectNoExecute {
+			started = metav1.NewTime(start)
+			taint.TimeAdded = &metav1.Time{Time: now}
+		}
+
+		if err := r.Patch(ctx, latestNode, client.MergeFromWithOptions(stored, client.MergeFromWithOptimisticLock{})); err != nil {
+			return err
+		}
+
+		*node = *latestNode
+		escalated = from != to
+		return nil
+	})
+	if err != nil {
+		return false, metav1.Time{}, err
+	}
+
+	if escalated {
+		log.Info("Escalated taint effect", "node", node.Name, "rule", rule.Name,
+			"taint", rule.Spec.Taint.Key, "from", from, "to", to)
+		message := fmt.Sprintf("Taint '%s' escalated from %s to %s by rule '%s'", rule.Spec.Taint.Key, from, to, rule.Name)
+		r.EventRecorder.Eventf(node, nil, corev1.EventTypeWarning, "TaintEscalated", "EscalateTaint", "%s", message)
+	}
+	return escalated, started, nil
+}
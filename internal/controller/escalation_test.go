@@ -0,0 +1,284 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// escalatingRule returns a continuous rule whose taint escalates from
+// PreferNoSchedule to NoSchedule after 5 minutes and NoExecute after an hour.
+func escalatingRule() *readinessv1alpha1.NodeReadinessRule {
+	rule := gpuRule()
+	rule.Spec.EnforcementMode = readinessv1alpha1.EnforcementModeContinuous
+	rule.Spec.Conditions = []readinessv1alpha1.ConditionRequirement{
+		{Type: "GPUReady", RequiredStatus: corev1.ConditionTrue},
+	}
+	rule.Spec.Taint.Effect = corev1.TaintEffectPreferNoSchedule
+	rule.Spec.TaintEscalation = []readinessv1alpha1.TaintEscalationStep{
+		{Effect: corev1.TaintEffectNoSchedule, AfterSeconds: 300},
+		{Effect: corev1.TaintEffectNoExecute, AfterSeconds: 3600},
+	}
+	return rule
+}
+
+// escalatingNode returns a failing node carrying the rule's taint with the
+// given effect, added the given duration ago.
+func escalatingNode(effect corev1.TaintEffect, age time.Duration) *corev1.Node {
+	node := gpuNode("gpu-node", false)
+	node.Status.Conditions = []corev1.NodeCondition{{Type: "GPUReady", Status: corev1.ConditionFalse}}
+	node.Spec.Taints = []corev1.Taint{{
+		Key:       gpuTaint().Key,
+		Value:     gpuTaint().Value,
+		Effect:    effect,
+		TimeAdded: &metav1.Time{Time: time.Now().Add(-age)},
+	}}
+	return node
+}
+
+func TestEscalatedEffect(t *testing.T) {
+	rule := escalatingRule()
+	added := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		age  time.Duration
+		want corev1.TaintEffect
+	}{
+		{name: "fresh taint keeps the initial effect", age: time.Minute, want: corev1.TaintEffectPreferNoSchedule},
+		{name: "first step is reached", age: 5 * time.Minute, want: corev1.TaintEffectNoSchedule},
+		{name: "between steps", age: 30 * time.Minute, want: corev1.TaintEffectNoSchedule},
+		{name: "last step is reached", age: 2 * time.Hour, want: corev1.TaintEffectNoExecute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(escalatedEffect(rule, added, added.Add(tt.age))).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestFindRuleTaint(t *testing.T) {
+	g := NewWithT(t)
+	rule := escalatingRule()
+
+	for _, effect := range []corev1.TaintEffect{
+		corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoSchedule, corev1.TaintEffectNoExecute,
+	} {
+		taint := findRuleTaint(escalatingNode(effect, time.Minute), rule)
+		g.Expect(taint).NotTo(BeNil())
+		g.Expect(taint.Effect).To(Equal(effect))
+	}
+
+	rule.Spec.TaintEscalation = nil
+	g.Expect(findRuleTaint(escalatingNode(corev1.TaintEffectNoExecute, time.Minute), rule)).To(BeNil())
+}
+
+func TestNextEscalationAfter(t *testing.T) {
+	g := NewWithT(t)
+	rule := escalatingRule()
+	now := time.Now()
+
+	node := escalatingNode(corev1.TaintEffectPreferNoSchedule, 0)
+	node.Spec.Taints[0].TimeAdded = &metav1.Time{Time: now.Add(-time.Minute)}
+	d, ok := nextEscalationAfter(rule, node, now)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(d).To(Equal(4 * time.Minute))
+
+	node.Spec.Taints[0].TimeAdded = &metav1.Time{Time: now.Add(-10 * time.Minute)}
+	d, ok = nextEscalationAfter(rule, node, now)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(d).To(Equal(50 * time.Minute))
+
+	node.Spec.Taints[0].TimeAdded = &metav1.Time{Time: now.Add(-2 * time.Hour)}
+	_, ok = nextEscalationAfter(rule, node, now)
+	g.Expect(ok).To(BeFalse())
+
+	node.Spec.Taints[0].TimeAdded = nil
+	_, ok = nextEscalationAfter(rule, node, now)
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestEvaluateRuleForNode_TaintEscalation(t *testing.T) {
+	tests := []struct {
+		name       string
+		node       *corev1.Node
+		wantEffect corev1.TaintEffect
+		wantEvent  string
+		// wantRestamped is set when the taint's TimeAdded is reset to now.
+		wantRestamped bool
+	}{
+		{
+			name:       "taint is escalated in place once a step is due",
+			node:       escalatingNode(corev1.TaintEffectPreferNoSchedule, 10*time.Minute),
+			wantEffect: corev1.TaintEffectNoSchedule,
+			wantEvent:  "TaintEscalated",
+		},
+		{
+			name:          "overdue steps are skipped straight to the current one",
+			node:          escalatingNode(corev1.TaintEffectPreferNoSchedule, 2*time.Hour),
+			wantEffect:    corev1.TaintEffectNoExecute,
+			wantEvent:     "TaintEscalated",
+			wantRestamped: true,
+		},
+		{
+			name:       "taint is left alone before the first step",
+			node:       escalatingNode(corev1.TaintEffectPreferNoSchedule, time.Minute),
+			wantEffect: corev1.TaintEffectPreferNoSchedule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			rule := escalatingRule()
+			// A previous evaluation means the taint is already managed, not adopted.
+			rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{{NodeName: tt.node.Name}}
+			fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(tt.node).Build()
+			recorder := events.NewFakeRecorder(10)
+			c := &RuleReadinessController{Client: fc, EventRecorder: recorder}
+
+			g.Expect(c.evaluateRuleForNode(ctx, rule, tt.node)).To(Succeed())
+
+			updated := &corev1.Node{}
+			g.Expect(fc.Get(ctx, client.ObjectKey{Name: tt.node.Name}, updated)).To(Succeed())
+			g.Expect(updated.Spec.Taints).To(HaveLen(1))
+			g.Expect(updated.Spec.Taints[0].Effect).To(Equal(tt.wantEffect))
+			if tt.wantRestamped {
+				g.Expect(updated.Spec.Taints[0].TimeAdded.Time).To(BeTemporally("~", time.Now(), time.Second))
+			} else {
+				g.Expect(updated.Spec.Taints[0].TimeAdded.Time).To(BeTemporally("~", tt.node.Spec.Taints[0].TimeAdded.Time, time.Second))
+			}
+
+			if tt.wantEvent != "" {
+				g.Expect(recorder.Events).To(Receive(ContainSubstring(tt.wantEvent)))
+			} else {
+				g.Expect(recorder.Events).NotTo(Receive())
+			}
+		})
+	}
+
+	t.Run("taint escalated to NoExecute is stamped again and keeps the start of its ladder", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := context.Background()
+		rule := escalatingRule()
+		rule.Spec.Drain.AfterSeconds = 7200
+		rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{{NodeName: "gpu-node"}}
+		node := escalatingNode(corev1.TaintEffectNoSchedule, 90*time.Minute)
+		added := node.Spec.Taints[0].TimeAdded.Time
+		fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+		c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+
+		g.Expect(c.evaluateRuleForNode(ctx, rule, node)).To(Succeed())
+
+		// Pods tolerating the taint for a bounded time are evicted after their
+		// toleration, counted from the escalation.
+		updated := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+		g.Expect(updated.Spec.Taints[0].Effect).To(Equal(corev1.TaintEffectNoExecute))
+		g.Expect(updated.Spec.Taints[0].TimeAdded.Time).To(BeTemporally("~", time.Now(), time.Second))
+		eval := c.getPreviousNodeEvaluation(rule, node.Name)
+		g.Expect(eval.EscalationStartTime.Time).To(BeTemporally("~", added, time.Second))
+
+		// The drain stays timed from the start of the ladder.
+		d, ok := drainRequeueAfter(rule, updated, time.Now())
+		g.Expect(ok).To(BeTrue())
+		g.Expect(d).To(BeNumerically("~", 30*time.Minute, time.Second))
+
+		// Later evaluations leave the escalated taint alone.
+		g.Expect(c.evaluateRuleForNode(ctx, rule, updated)).To(Succeed())
+		again := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, again)).To(Succeed())
+		g.Expect(again.Spec.Taints[0].TimeAdded.Time).To(BeTemporally("~", updated.Spec.Taints[0].TimeAdded.Time, time.Second))
+		g.Expect(c.getPreviousNodeEvaluation(rule, node.Name).EscalationStartTime.Time).To(BeTemporally("~", added, time.Second))
+	})
+
+	t.Run("escalated taint is removed once the node recovers", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := context.Background()
+		rule := escalatingRule()
+		node := escalatingNode(corev1.TaintEffectNoExecute, 2*time.Hour)
+		node.Status.Conditions[0].Status = corev1.ConditionTrue
+		fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+		c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+
+		g.Expect(c.evaluateRuleForNode(ctx, rule, node)).To(Succeed())
+
+		updated := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+		g.Expect(updated.Spec.Taints).To(BeEmpty())
+	})
+
+	t.Run("added taint starts at the initial effect with TimeAdded stamped", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := context.Background()
+		rule := escalatingRule()
+		node := escalatingNode(corev1.TaintEffectPreferNoSchedule, 0)
+		node.Spec.Taints = nil
+		fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+		c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+
+		g.Expect(c.evaluateRuleForNode(ctx, rule, node)).To(Succeed())
+
+		updated := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+		g.Expect(updated.Spec.Taints).To(HaveLen(1))
+		g.Expect(updated.Spec.Taints[0].Effect).To(Equal(corev1.TaintEffectPreferNoSchedule))
+		g.Expect(updated.Spec.Taints[0].TimeAdded).NotTo(BeNil())
+	})
+}
+
+func TestCleanupTaintsForRule_TaintEscalation(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	rule := escalatingRule()
+	node := escalatingNode(corev1.TaintEffectNoExecute, 2*time.Hour)
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+	c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+
+	g.Expect(c.cleanupTaintsForRule(ctx, rule, &corev1.NodeList{Items: []corev1.Node{*node}})).To(Succeed())
+
+	updated := &corev1.Node{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+	g.Expect(updated.Spec.Taints).To(BeEmpty())
+}
+
+func TestProcessDryRun_TaintEscalation(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	rule := escalatingRule()
+	node := escalatingNode(corev1.TaintEffectPreferNoSchedule, 10*time.Minute)
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+	c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+
+	g.Expect(c.processDryRun(ctx, rule, &corev1.NodeList{Items: []corev1.Node{*node}})).To(Succeed())
+	g.Expect(*rule.Status.DryRunResults.TaintsToEscalate).To(BeEquivalentTo(1))
+	g.Expect(rule.Status.DryRunResults.Summary).To(ContainSubstring("would escalate 1 taints"))
+}
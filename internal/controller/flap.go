@@ -0,0 +1,173 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// clearQuarantineAnnotationKey lifts the quarantine of a Node for every rule.
+// The controller removes the annotation once the quarantine has been cleared.
+const clearQuarantineAnnotationKey = "readiness.k8s.io/clear-quarantine"
+
+// flapDetectionEnabled reports whether the rule has flap detection configured.
+func flapDetectionEnabled(rule *readinessv1alpha1.NodeReadinessRule) bool {
+	return rule.Spec.FlapDetection.TransitionThreshold > 0
+}
+
+// isQuarantined reports whether the flap state marks the Node as quarantined.
+func isQuarantined(state readinessv1alpha1.FlapState) bool {
+	return !state.QuarantineStartTime.IsZero()
+}
+
+// hasClearQuarantineAnnotation reports whether an operator asked to lift the Node's quarantine.
+func hasClearQuarantineAnnotation(node *corev1.Node) bool {
+	_, exists := node.Annotations[clearQuarantineAnnotationKey]
+	return exists
+}
+
+// newFlapState returns a flap state with no transitions, whose window starts now.
+func newFlapState(result readinessv1alpha1.EvaluationResult, now time.Time) readinessv1alpha1.FlapState {
+	transitions := int32(0)
+	return readinessv1alpha1.FlapState{
+		LastResult:      result,
+		Transitions:     &transitions,
+		WindowStartTime: metav1.NewTime(now),
+	}
+}
+
+// nextFlapState folds the latest evaluation result into the previous flap
+// state. Transitions are counted within a fixed window that restarts with the
+// first transition after it has elapsed. A Node is quarantined once the count
+// reaches the threshold, and released once it has gone cooldownSeconds without
+// a transition.
+func nextFlapState(
+	config readinessv1alpha1.FlapDetection,
+	previous readinessv1alpha1.FlapState,
+	result readinessv1alpha1.EvaluationResult,
+	now time.Time,
+) readinessv1alpha1.FlapState {
+	window := time.Duration(config.WindowSeconds) * time.Second
+	cooldown := time.Duration(config.CooldownSeconds) * time.Second
+
+	state := *previous.DeepCopy()
+	if state.LastResult == "" {
+		return newFlapState(result, now)
+	}
+
+	transitions := int32(0)
+	if state.Transitions != nil {
+		transitions = *state.Transitions
+	}
+	windowElapsed := now.Sub(state.WindowStartTime.Time) > window
+
+	switch {
+	case state.LastResult != result:
+		if windowElapsed {
+			state.WindowStartTime = metav1.NewTime(now)
+			transitions = 0
+		}
+		transitions++
+		state.LastResult = result
+		state.LastTransitionTime = metav1.NewTime(now)
+	case windowElapsed && !isQuarantined(state):
+		state.WindowStartTime = metav1.NewTime(now)
+		transitions = 0
+	}
+	state.Transitions = &transitions
+
+	switch {
+	case !isQuarantined(state) && transitions >= config.TransitionThreshold:
+		state.QuarantineStartTime = metav1.NewTime(now)
+	case isQuarantined(state) && now.Sub(state.LastTransitionTime.Time) >= cooldown:
+		state = newFlapState(result, now)
+	}
+
+	return state
+}
+
+// trackFlapping updates the Node's flap state for the rule and reports
+// whether the Node is quarantined. Quarantine changes are logged and recorded
+// as events on the Node.
+func (r *RuleReadinessController) trackFlapping(
+	ctx context.Context,
+	node *corev1.Node,
+	rule *readinessv1alpha1.NodeReadinessRule,
+	previous *readinessv1alpha1.NodeEvaluation,
+	conditionsSatisfied bool,
+) readinessv1alpha1.FlapState {
+	log := ctrl.LoggerFrom(ctx)
+
+	var previousState readinessv1alpha1.FlapState
+	if previous != nil {
+		previousState = previous.Flap
+	}
+
+	result := readinessv1alpha1.EvaluationResultUnsatisfied
+	if conditionsSatisfied {
+		result = readinessv1alpha1.EvaluationResultSatisfied
+	}
+
+	now := time.Now()
+	if isQuarantined(previousState) && hasClearQuarantineAnnotation(node) {
+		log.Info("Quarantine cleared by operator", "node", node.Name, "rule", rule.Name)
+		r.EventRecorder.Eventf(node, nil, corev1.EventTypeNormal, "QuarantineCleared", "ClearQuarantine",
+			"Quarantine for rule '%s' cleared by annotation '%s'", rule.Name, clearQuarantineAnnotationKey)
+		return newFlapState(result, now)
+	}
+
+	state := nextFlapState(rule.Spec.FlapDetection, previousState, result, now)
+
+	switch {
+	case !isQuarantined(previousState) && isQuarantined(state):
+		log.Info("Quarantining flapping node", "node", node.Name, "rule", rule.Name,
+			"transitions", *state.Transitions, "windowSeconds", rule.Spec.FlapDetection.WindowSeconds)
+		r.EventRecorder.Eventf(node, nil, corev1.EventTypeWarning, "NodeQuarantined", "QuarantineNode",
+			"Node quarantined by rule '%s' after %d condition transitions within %ds",
+			rule.Name, *state.Transitions, rule.Spec.FlapDetection.WindowSeconds)
+	case isQuarantined(previousState) && !isQuarantined(state):
+		log.Info("Releasing node from quarantine", "node", node.Name, "rule", rule.Name)
+		r.EventRecorder.Eventf(node, nil, corev1.EventTypeNormal, "QuarantineReleased", "ReleaseQuarantine",
+			"Node released from quarantine by rule '%s' after being stable for %ds",
+			rule.Name, rule.Spec.FlapDetection.CooldownSeconds)
+	}
+
+	return state
+}
+
+// quarantineRequeueAfter returns how long until the Node's quarantine for the
+// rule may be lifted, so the Node can be re-evaluated even if it stays stable.
+func quarantineRequeueAfter(rule *readinessv1alpha1.NodeReadinessRule, nodeName string, now time.Time) (time.Duration, bool) {
+	if !flapDetectionEnabled(rule) {
+		return 0, false
+	}
+	for _, eval := range rule.Status.NodeEvaluations {
+		if eval.NodeName != nodeName || !isQuarantined(eval.Flap) {
+			continue
+		}
+		cooldown := time.Duration(rule.Spec.FlapDetection.CooldownSeconds) * time.Second
+		return max(eval.Flap.LastTransitionTime.Add(cooldown).Sub(now), time.Second), true
+	}
+	return 0, false
+}
@@ -0,0 +1,219 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+func TestNextFlapState(t *testing.T) {
+	const (
+		satisfied   = readinessv1alpha1.EvaluationResultSatisfied
+		unsatisfied = readinessv1alpha1.EvaluationResultUnsatisfied
+	)
+	config := readinessv1alpha1.FlapDetection{
+		TransitionThreshold: 3,
+		WindowSeconds:       60,
+		CooldownSeconds:     300,
+	}
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(seconds int) time.Time { return start.Add(time.Duration(seconds) * time.Second) }
+
+	t.Run("first evaluation starts a window without transitions", func(t *testing.T) {
+		g := NewWithT(t)
+		state := nextFlapState(config, readinessv1alpha1.FlapState{}, satisfied, start)
+		g.Expect(state.LastResult).To(Equal(satisfied))
+		g.Expect(*state.Transitions).To(BeEquivalentTo(0))
+		g.Expect(state.WindowStartTime.Time).To(Equal(start))
+		g.Expect(isQuarantined(state)).To(BeFalse())
+	})
+
+	t.Run("unchanged result does not count as a transition", func(t *testing.T) {
+		g := NewWithT(t)
+		state := nextFlapState(config, readinessv1alpha1.FlapState{}, satisfied, start)
+		state = nextFlapState(config, state, satisfied, at(10))
+		g.Expect(*state.Transitions).To(BeEquivalentTo(0))
+		g.Expect(state.LastTransitionTime.IsZero()).To(BeTrue())
+	})
+
+	t.Run("reaching the threshold within the window quarantines", func(t *testing.T) {
+		g := NewWithT(t)
+		state := nextFlapState(config, readinessv1alpha1.FlapState{}, satisfied, start)
+		state = nextFlapState(config, state, unsatisfied, at(10))
+		state = nextFlapState(config, state, satisfied, at(20))
+		g.Expect(isQuarantined(state)).To(BeFalse())
+		state = nextFlapState(config, state, unsatisfied, at(30))
+		g.Expect(*state.Transitions).To(BeEquivalentTo(3))
+		g.Expect(isQuarantined(state)).To(BeTrue())
+		g.Expect(state.QuarantineStartTime.Time).To(Equal(at(30)))
+	})
+
+	t.Run("transitions spread over several windows do not quarantine", func(t *testing.T) {
+		g := NewWithT(t)
+		state := nextFlapState(config, readinessv1alpha1.FlapState{}, satisfied, start)
+		state = nextFlapState(config, state, unsatisfied, at(10))
+		state = nextFlapState(config, state, satisfied, at(50))
+		state = nextFlapState(config, state, unsatisfied, at(100))
+		g.Expect(*state.Transitions).To(BeEquivalentTo(1))
+		g.Expect(state.WindowStartTime.Time).To(Equal(at(100)))
+		g.Expect(isQuarantined(state)).To(BeFalse())
+	})
+
+	t.Run("quarantine is held until the cooldown has passed without transitions", func(t *testing.T) {
+		g := NewWithT(t)
+		state := nextFlapState(config, readinessv1alpha1.FlapState{}, satisfied, start)
+		state = nextFlapState(config, state, unsatisfied, at(10))
+		state = nextFlapState(config, state, satisfied, at(20))
+		state = nextFlapState(config, state, unsatisfied, at(30))
+		g.Expect(isQuarantined(state)).To(BeTrue())
+
+		state = nextFlapState(config, state, satisfied, at(200))
+		g.Expect(isQuarantined(state)).To(BeTrue(), "transition during quarantine restarts the cooldown")
+
+		state = nextFlapState(config, state, satisfied, at(450))
+		g.Expect(isQuarantined(state)).To(BeTrue())
+
+		state = nextFlapState(config, state, satisfied, at(500))
+		g.Expect(isQuarantined(state)).To(BeFalse())
+		g.Expect(*state.Transitions).To(BeEquivalentTo(0))
+		g.Expect(state.LastResult).To(Equal(satisfied))
+	})
+}
+
+func TestQuarantineRequeueAfter(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+	rule := gpuRule()
+	rule.Spec.FlapDetection = readinessv1alpha1.FlapDetection{TransitionThreshold: 2, WindowSeconds: 60, CooldownSeconds: 300}
+	rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{
+		{NodeName: "stable-node"},
+		{NodeName: "flapping-node", Flap: readinessv1alpha1.FlapState{
+			LastResult:          readinessv1alpha1.EvaluationResultSatisfied,
+			WindowStartTime:     metav1.NewTime(now.Add(-2 * time.Minute)),
+			LastTransitionTime:  metav1.NewTime(now.Add(-time.Minute)),
+			QuarantineStartTime: metav1.NewTime(now.Add(-time.Minute)),
+		}},
+	}
+
+	_, ok := quarantineRequeueAfter(rule, "stable-node", now)
+	g.Expect(ok).To(BeFalse())
+
+	d, ok := quarantineRequeueAfter(rule, "flapping-node", now)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(d).To(Equal(4 * time.Minute))
+
+	rule.Spec.FlapDetection = readinessv1alpha1.FlapDetection{}
+	_, ok = quarantineRequeueAfter(rule, "flapping-node", now)
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestEvaluateRuleForNode_FlapDetection(t *testing.T) {
+	newRule := func() *readinessv1alpha1.NodeReadinessRule {
+		rule := gpuRule()
+		rule.Spec.EnforcementMode = readinessv1alpha1.EnforcementModeContinuous
+		rule.Spec.Conditions = []readinessv1alpha1.ConditionRequirement{
+			{Type: "GPUReady", RequiredStatus: corev1.ConditionTrue},
+		}
+		rule.Spec.FlapDetection = readinessv1alpha1.FlapDetection{
+			TransitionThreshold: 2,
+			WindowSeconds:       600,
+			CooldownSeconds:     600,
+		}
+		return rule
+	}
+	setCondition := func(node *corev1.Node, status corev1.ConditionStatus) {
+		node.Status.Conditions = []corev1.NodeCondition{{Type: "GPUReady", Status: status}}
+	}
+
+	t.Run("flapping node is quarantined and keeps its taint", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := context.Background()
+		rule := newRule()
+		node := gpuNode("gpu-node", true)
+		setCondition(node, corev1.ConditionTrue)
+		fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+		recorder := events.NewFakeRecorder(10)
+		c := &RuleReadinessController{Client: fc, EventRecorder: recorder}
+
+		for _, status := range []corev1.ConditionStatus{corev1.ConditionTrue, corev1.ConditionFalse, corev1.ConditionTrue} {
+			latest := &corev1.Node{}
+			g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, latest)).To(Succeed())
+			setCondition(latest, status)
+			g.Expect(c.evaluateRuleForNode(ctx, rule, latest)).To(Succeed())
+		}
+
+		updated := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+		g.Expect(c.hasTaintBySpec(updated, rule.Spec.Taint)).To(BeTrue())
+
+		eval := c.getPreviousNodeEvaluation(rule, node.Name)
+		g.Expect(eval).NotTo(BeNil())
+		g.Expect(isQuarantined(eval.Flap)).To(BeTrue())
+		g.Expect(eval.TaintStatus).To(Equal(readinessv1alpha1.TaintStatusPresent))
+
+		var reasons []string
+		for len(recorder.Events) > 0 {
+			reasons = append(reasons, <-recorder.Events)
+		}
+		g.Expect(reasons).To(ContainElement(ContainSubstring("NodeQuarantined")))
+	})
+
+	t.Run("clear-quarantine annotation lifts the quarantine", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := context.Background()
+		rule := newRule()
+		now := time.Now()
+		rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{{
+			NodeName: "gpu-node",
+			Flap: readinessv1alpha1.FlapState{
+				LastResult:          readinessv1alpha1.EvaluationResultSatisfied,
+				WindowStartTime:     metav1.NewTime(now),
+				LastTransitionTime:  metav1.NewTime(now),
+				QuarantineStartTime: metav1.NewTime(now),
+			},
+		}}
+		node := gpuNode("gpu-node", true)
+		node.Annotations = map[string]string{clearQuarantineAnnotationKey: ""}
+		setCondition(node, corev1.ConditionTrue)
+		fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+		recorder := events.NewFakeRecorder(10)
+		c := &RuleReadinessController{Client: fc, EventRecorder: recorder}
+
+		g.Expect(c.evaluateRuleForNode(ctx, rule, node)).To(Succeed())
+
+		updated := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+		g.Expect(c.hasTaintBySpec(updated, rule.Spec.Taint)).To(BeFalse())
+		g.Expect(isQuarantined(c.getPreviousNodeEvaluation(rule, node.Name).Flap)).To(BeFalse())
+		g.Expect(recorder.Events).To(Receive(ContainSubstring("QuarantineCleared")))
+
+		g.Expect(c.removeNodeAnnotations(ctx, node.Name, clearQuarantineAnnotationKey)).To(Succeed())
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+		g.Expect(hasClearQuarantineAnnotation(updated)).To(BeFalse())
+	})
+}
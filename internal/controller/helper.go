@@ -32,7 +32,7 @@ const (
 	// ~36 chars), which is immutable for the object's lifetime and globally unique.
 	//
 	// Full key format: readiness.k8s.io/bootstrap-completed-<ruleUID>
-	// Value format:    {"rule-name":"<ruleName>"}   (for human readability)
+	// Value format:    {"rule-name":"<ruleName>","bootstrap-id":"<bootstrapID>","boot-id":"<bootID>","kubelet-version":"<version>"}
 	bootstrapAnnotationPrefix = "readiness.k8s.io/bootstrap-completed-"
 )
 
@@ -42,19 +42,54 @@ func bootstrapAnnotationKey(uid types.UID) string {
 	return bootstrapAnnotationPrefix + string(uid)
 }
 
-// bootstrapAnnotationValue returns the JSON-encoded value to store in the
-// bootstrap annotation. It includes the rule name for human readability.
-func bootstrapAnnotationValue(ruleName string) string {
-	v := struct {
-		RuleName string `json:"rule-name"`
-	}{RuleName: ruleName}
-	b, err := json.Marshal(v)
+// bootstrapCompletion is the JSON-encoded value of a bootstrap completion
+// annotation. Besides the rule name and bootstrap ID, it records the boot and
+// the kubelet version the Node completed bootstrap with, so that bootstrap
+// can be re-armed when either changes.
+type bootstrapCompletion struct {
+	RuleName       string `json:"rule-name"`
+	BootstrapID    string `json:"bootstrap-id,omitempty"`
+	BootID         string `json:"boot-id,omitempty"`
+	KubeletVersion string `json:"kubelet-version,omitempty"`
+}
+
+// String returns the JSON encoding of the completion.
+func (c bootstrapCompletion) String() string {
+	b, err := json.Marshal(c)
 	if err != nil {
 		return `{"rule-name":""}` // should never happen
 	}
 	return string(b)
 }
 
+// bootstrapAnnotationValue returns the JSON-encoded value to store in the
+// bootstrap annotation. It includes the rule name for human readability.
+func bootstrapAnnotationValue(ruleName string) string {
+	return bootstrapCompletion{RuleName: ruleName}.String()
+}
+
+// nodeBootstrapAnnotationValue returns the bootstrap annotation value for a
+// node completing bootstrap, recording its current boot ID and kubelet version.
+func nodeBootstrapAnnotationValue(rule *readinessv1alpha1.NodeReadinessRule, node *corev1.Node) string {
+	return bootstrapCompletion{
+		RuleName:       rule.Name,
+		BootstrapID:    rule.Spec.BootstrapID,
+		BootID:         node.Status.NodeInfo.BootID,
+		KubeletVersion: node.Status.NodeInfo.KubeletVersion,
+	}.String()
+}
+
+// parseBootstrapAnnotationValue decodes a bootstrap annotation value. Values
+// written by older versions, e.g. "true" on legacy keys, yield an empty
+// completion.
+func parseBootstrapAnnotationValue(value string) bootstrapCompletion {
+	var c bootstrapCompletion
+	if err := json.Unmarshal([]byte(value), &c); err != nil {
+		return bootstrapCompletion{}
+	}
+	return c
+}
+
 // legacyBootstrapAnnotationKey returns the old-format annotation key used
 // before the UID migration: readiness.k8s.io/bootstrap-completed-<ruleName>.
 func legacyBootstrapAnnotationKey(ruleName string) string {
@@ -48,6 +48,21 @@ func TestBootstrapAnnotationValue(t *testing.T) {
 		val := bootstrapAnnotationValue(longName)
 		g.Expect(val).To(ContainSubstring(longName))
 	})
+
+	t.Run("records the bootstrap ID and the node's boot ID and kubelet version", func(t *testing.T) {
+		node := &corev1.Node{Status: corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{BootID: "boot-1", KubeletVersion: "v1.36.0"}}}
+		rule := &readinessv1alpha1.NodeReadinessRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-rule"},
+			Spec:       readinessv1alpha1.NodeReadinessRuleSpec{BootstrapID: "gpu"},
+		}
+		val := nodeBootstrapAnnotationValue(rule, node)
+		g.Expect(val).To(Equal(`{"rule-name":"my-rule","bootstrap-id":"gpu","boot-id":"boot-1","kubelet-version":"v1.36.0"}`))
+		g.Expect(parseBootstrapAnnotationValue(val)).To(Equal(bootstrapCompletion{RuleName: "my-rule", BootstrapID: "gpu", BootID: "boot-1", KubeletVersion: "v1.36.0"}))
+	})
+
+	t.Run("parses legacy values as empty", func(t *testing.T) {
+		g.Expect(parseBootstrapAnnotationValue("true")).To(Equal(bootstrapCompletion{}))
+	})
 }
 
 func TestLegacyBootstrapAnnotationKey(t *testing.T) {
@@ -20,8 +20,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -48,6 +51,7 @@ type NodeReconciler struct {
 // SetupWithManager sets up the controller with the Manager.
 func (r *NodeReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	concurrency := max(r.MaxConcurrentReconciles, 1)
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("node").
 		WithOptions(controller.Options{MaxConcurrentReconciles: concurrency}).
@@ -70,15 +74,25 @@ func (r *NodeReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 				conditionsChanged := !conditionsEqual(oldNode.Status.Conditions, newNode.Status.Conditions)
 				taintsChanged := !taintsEqual(oldNode.Spec.Taints, newNode.Spec.Taints)
 				labelsChanged := !labelsEqual(oldNode.Labels, newNode.Labels)
+				overridesChanged := !overrideAnnotationsEqual(oldNode.Annotations, newNode.Annotations)
+				quarantineCleared := !hasClearQuarantineAnnotation(oldNode) && hasClearQuarantineAnnotation(newNode)
+				nodeInfoChanged := oldNode.Status.NodeInfo.BootID != newNode.Status.NodeInfo.BootID ||
+					oldNode.Status.NodeInfo.KubeletVersion != newNode.Status.NodeInfo.KubeletVersion
+				rearmRequested := !hasRearmBootstrapAnnotation(oldNode) && hasRearmBootstrapAnnotation(newNode)
 
-				shouldReconcile := conditionsChanged || taintsChanged || labelsChanged
+				shouldReconcile := conditionsChanged || taintsChanged || labelsChanged || overridesChanged || quarantineCleared ||
+					nodeInfoChanged || rearmRequested
 
 				if shouldReconcile {
 					log.V(4).Info("NodeReconciler processing node update event",
 						"node", newNode.Name,
 						"conditionsChanged", conditionsChanged,
 						"taintsChanged", taintsChanged,
-						"labelsChanged", labelsChanged)
+						"labelsChanged", labelsChanged,
+						"overridesChanged", overridesChanged,
+						"quarantineCleared", quarantineCleared,
+						"nodeInfoChanged", nodeInfoChanged,
+						"rearmRequested", rearmRequested)
 				}
 
 				return shouldReconcile
@@ -99,20 +113,43 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	// Fetch the node
 	node := &corev1.Node{}
 	if err := r.Get(ctx, req.NamespacedName, node); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
+		if apierrors.IsNotFound(err) {
+			// Drop the deleted node from the dry run results of rules.
+			return ctrl.Result{}, r.Controller.forgetDryRunNode(ctx, req.Name)
+		}
+		return ctrl.Result{}, err
 	}
 
+	// Release the node from rules whose nodeSelector it no longer matches
+	releaseErr := r.Controller.releaseNodeFromOutOfScopeRules(ctx, node)
+
 	// Process node against all applicable rules
-	if err := r.Controller.processNodeAgainstAllRules(ctx, node); err != nil {
+	requeueAfter, err := r.Controller.processNodeAgainstAllRules(ctx, node)
+	if err := errors.Join(releaseErr, err); err != nil {
 		return ctrl.Result{}, err
 	}
 
+	// Re-evaluate the node once its earliest override expires, as nothing else
+	// would trigger a reconcile at that point.
+	if expiry, ok := nextOverrideExpiry(node, time.Now()); ok && (requeueAfter == 0 || expiry < requeueAfter) {
+		requeueAfter = expiry
+	}
+
+	if requeueAfter > 0 {
+		log.V(4).Info("Requeuing node for time-based re-evaluation", "node", node.Name, "requeueAfter", requeueAfter)
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
 // processNodeAgainstAllRules processes a single node against all applicable rules.
-func (r *RuleReadinessController) processNodeAgainstAllRules(ctx context.Context, node *corev1.Node) error {
+// It returns how long until the node needs to be re-evaluated for a quarantine
+// to be lifted, a taint to be escalated or a drain to progress, or zero if no
+// re-evaluation is pending.
+func (r *RuleReadinessController) processNodeAgainstAllRules(ctx context.Context, node *corev1.Node) (time.Duration, error) {
 	log := ctrl.LoggerFrom(ctx)
+	var requeueAfter time.Duration
 
 	// Get all known (cached) applicable rules for this node
 	applicableRules := r.getApplicableRulesForNode(ctx, node)
@@ -120,6 +157,7 @@ func (r *RuleReadinessController) processNodeAgainstAllRules(ctx context.Context
 	log.Info("Processing node against rules", "node", node.Name, "ruleCount", len(applicableRules))
 
 	for _, rule := range applicableRules {
+		r.trackRuleNode(rule.Name, node.Name)
 		log.V(4).Info("Processing rule from cache",
 			"node", node.Name,
 			"rule", rule.Name,
@@ -133,19 +171,80 @@ func (r *RuleReadinessController) processNodeAgainstAllRules(ctx context.Context
 			continue
 		}
 
-		// Skip if bootstrap-only and already completed
-		if rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly && r.isBootstrapCompleted(ctx, node.Name, rule.Name, rule.GetUID()) {
+		// Skip rules suspended by their schedule; the rule reconciler
+		// re-evaluates all nodes once they become active again.
+		if !r.ruleScheduleActive(rule, time.Now()) {
+			log.V(4).Info("Skipping rule outside its schedule",
+				"node", node.Name,
+				"rule", rule.Name)
+			continue
+		}
+
+		// The cached rule only tracks spec changes; refresh its status so the
+		// re-arm sees the current rollout and the evaluation builds on the
+		// latest per-node state.
+		latestRule := &readinessv1alpha1.NodeReadinessRule{}
+		if err := r.Get(ctx, client.ObjectKey{Name: rule.Name}, latestRule); err == nil {
+			rule.Status = latestRule.Status
+		}
+
+		// Re-arm bootstrap if one of the rule's triggers fired since completion
+		rearmed, rearmErr := r.rearmBootstrapIfTriggered(ctx, node, rule)
+		if rearmErr != nil {
+			log.Error(rearmErr, "Failed to re-arm bootstrap", "node", node.Name, "rule", rule.Name)
+			errs = append(errs, rearmErr)
+			continue
+		}
+
+		// Skip if bootstrap-only and already completed; the cache may not have
+		// caught up with a re-arm yet.
+		if !rearmed && rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly && r.isBootstrapCompleted(ctx, node.Name, rule) {
 			log.Info("Skipping bootstrap-only rule - already completed",
 				"node", node.Name, "rule", rule.Name)
 			continue
 		}
 
-		// Skip if dry run
-		if rule.Spec.DryRun {
-			log.Info("Skipping rule - dry run mode",
-				"node", node.Name, "rule", rule.Name)
+		if r.NodeStatusObjects {
+			if err := r.loadNodeStatus(ctx, rule, node.Name); err != nil {
+				log.Error(err, "Failed to load node status", "node", node.Name, "rule", rule.Name)
+				errs = append(errs, err)
+				continue
+			}
+		}
+
+		// Only preview the changes of rules in dry run mode, and of rules whose
+		// progressive rollout has not reached the node yet.
+		if rule.Spec.DryRun || !inRolloutSubset(rule, node.Name) {
+			log.V(4).Info("Updating dry run of rule for node",
+				"node", node.Name,
+				"rule", rule.Name,
+				"dryRun", rule.Spec.DryRun,
+				"percent", rolloutPercent(rule))
+			// Release the node if the rollout was scaled back since it was enforced on it.
+			if !rule.Spec.DryRun && nodeInRuleStatus(rule, node.Name) {
+				if err := r.releaseNodeFromRule(ctx, rule, node, "NodeLeftRollout", rolloutExitMessage(rule)); err != nil {
+					log.Error(err, "Failed to release node left out of the rollout", "node", node.Name, "rule", rule.Name)
+					errs = append(errs, err)
+					continue
+				}
+			}
+			if err := r.updateNodeDryRun(ctx, rule, node, true); err != nil {
+				log.Error(err, "Failed to update dry run results", "node", node.Name, "rule", rule.Name)
+				errs = append(errs, err)
+			}
 			continue
 		}
+		if hasRollout(rule) {
+			if err := r.updateNodeDryRun(ctx, rule, node, false); err != nil {
+				log.Error(err, "Failed to update dry run results", "node", node.Name, "rule", rule.Name)
+				errs = append(errs, err)
+			}
+		}
+
+		// What the node contributed to the rule's node counts before this
+		// evaluation, which is updated in place.
+		previous := ruleEvaluationFor(rule, node.Name)
+		previousCount := r.nodeCountOf(rule, node, previous)
 
 		log.Info("Evaluating rule for node",
 			"node", node.Name,
@@ -163,6 +262,17 @@ func (r *RuleReadinessController) processNodeAgainstAllRules(ctx context.Context
 			// Clear any stale failures from previous reconciliation attempts.
 			r.clearNodeFailure(rule, node.Name)
 		}
+		r.recordInlineNodeTransitions(rule, node.Name, previous)
+
+		if d, ok := quarantineRequeueAfter(rule, node.Name, time.Now()); ok && (requeueAfter == 0 || d < requeueAfter) {
+			requeueAfter = d
+		}
+		if d, ok := nextEscalationAfter(rule, node, time.Now()); ok && (requeueAfter == 0 || d < requeueAfter) {
+			requeueAfter = d
+		}
+		if d, ok := drainRequeueAfter(rule, node, time.Now()); ok && (requeueAfter == 0 || d < requeueAfter) {
+			requeueAfter = d
+		}
 
 		// Persist the rule status
 		log.V(4).Info("Attempting to persist rule status",
@@ -170,6 +280,14 @@ func (r *RuleReadinessController) processNodeAgainstAllRules(ctx context.Context
 			"rule", rule.Name,
 			"resourceVersion", rule.ResourceVersion)
 
+		if r.NodeStatusObjects {
+			if err := r.storeNodeStatus(ctx, rule, node); err != nil {
+				log.Error(err, "Failed to store node status", "node", node.Name, "rule", rule.Name)
+				errs = append(errs, err)
+				continue
+			}
+		}
+
 		var successfullyPatchedRule *readinessv1alpha1.NodeReadinessRule
 
 		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -179,6 +297,18 @@ func (r *RuleReadinessController) processNodeAgainstAllRules(ctx context.Context
 			}
 
 			patch := client.MergeFrom(latestRule.DeepCopy())
+			// Only the node's own change is applied to the counts, which the
+			// rule reconciler recounts from all the nodes.
+			addRuleNodeCounts(latestRule, r.nodeCountOf(rule, node, ruleEvaluationFor(rule, node.Name)).sub(previousCount))
+			if r.NodeStatusObjects {
+				// The node's entries were stored in its NodeReadinessStatus.
+				setRuleConditions(latestRule)
+				if err := r.Status().Patch(ctx, latestRule, patch); err != nil {
+					return err
+				}
+				successfullyPatchedRule = latestRule
+				return nil
+			}
 
 			// update only this specific node evaluation status
 			currEval := readinessv1alpha1.NodeEvaluation{}
@@ -217,6 +347,7 @@ func (r *RuleReadinessController) processNodeAgainstAllRules(ctx context.Context
 				}
 			}
 			latestRule.Status.FailedNodes = updatedFailedNodes
+			setRuleConditions(latestRule)
 
 			if err := r.Status().Patch(ctx, latestRule, patch); err != nil {
 				return err
@@ -241,13 +372,48 @@ func (r *RuleReadinessController) processNodeAgainstAllRules(ctx context.Context
 
 			if r.EnableNodeStateMetrics {
 				if successfullyPatchedRule != nil {
-					r.SyncNodeStateMetrics(ctx, successfullyPatchedRule)
+					r.syncRuleNodeStateMetrics(ctx, successfullyPatchedRule)
 				}
 			}
 		}
 	}
 
-	return errors.Join(errs...)
+	// The clear-quarantine annotation is a one-shot request, consumed once all rules have seen it.
+	if hasClearQuarantineAnnotation(node) && len(errs) == 0 {
+		if err := r.removeNodeAnnotations(ctx, node.Name, clearQuarantineAnnotationKey); err != nil {
+			log.Error(err, "Failed to remove clear-quarantine annotation", "node", node.Name)
+			errs = append(errs, err)
+		}
+	}
+
+	// The rearm-bootstrap annotation is a one-shot request too.
+	if hasRearmBootstrapAnnotation(node) && len(errs) == 0 {
+		if err := r.removeNodeAnnotations(ctx, node.Name, rearmBootstrapAnnotationKey); err != nil {
+			log.Error(err, "Failed to remove rearm-bootstrap annotation", "node", node.Name)
+			errs = append(errs, err)
+		}
+	}
+
+	return requeueAfter, errors.Join(errs...)
+}
+
+// removeNodeAnnotations removes annotations from a node, if present.
+func (r *RuleReadinessController) removeNodeAnnotations(ctx context.Context, nodeName string, keys ...string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestNode := &corev1.Node{}
+		if err := r.Get(ctx, client.ObjectKey{Name: nodeName}, latestNode); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		stored := latestNode.DeepCopy()
+		for _, key := range keys {
+			delete(latestNode.Annotations, key)
+		}
+		if len(latestNode.Annotations) == len(stored.Annotations) {
+			return nil
+		}
+		return r.Patch(ctx, latestNode, client.MergeFromWithOptions(stored, client.MergeFromWithOptimisticLock{}))
+	})
 }
 
 // getConditionStatus gets the status of a condition on a node.
@@ -297,20 +463,23 @@ func (r *RuleReadinessController) addTaintBySpec(ctx context.Context, node *core
 			return err
 		}
 
-		// Check if taint already exists
-		if r.hasTaintBySpec(latestNode, taintSpec) {
+		// Check if taint already exists, at any step of the escalation ladder
+		if r.hasRuleTaint(latestNode, rule) {
 			return nil
 		}
 
 		if rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly &&
-			nodeHasBootstrapAnnotation(latestNode, rule) {
+			r.nodeHasBootstrapAnnotation(latestNode, rule) {
 			log.Info("Skipping taint addition - bootstrap already completed",
 				"node", latestNode.Name, "rule", rule.Name, "taint", taintSpec.Key)
 			return nil
 		}
 
 		stored := latestNode.DeepCopy()
-		latestNode.Spec.Taints = append(latestNode.Spec.Taints, taintSpec)
+		// TimeAdded is stamped so the taint can be escalated over time.
+		taint := taintSpec
+		taint.TimeAdded = &metav1.Time{Time: time.Now()}
+		latestNode.Spec.Taints = append(latestNode.Spec.Taints, taint)
 		if err := r.Patch(ctx, latestNode, client.MergeFromWithOptions(stored, client.MergeFromWithOptimisticLock{})); err != nil {
 			return err
 		}
@@ -332,7 +501,14 @@ func (r *RuleReadinessController) addTaintBySpec(ctx context.Context, node *core
 
 // removeTaintBySpec removes a taint from a node.
 func (r *RuleReadinessController) removeTaintBySpec(ctx context.Context, node *corev1.Node, taintSpec corev1.Taint, ruleName string) error {
-	_, err := r.removeTaint(ctx, node, taintSpec, ruleName, nil)
+	_, err := r.removeTaint(ctx, node, taintSpec.Key, []corev1.TaintEffect{taintSpec.Effect}, ruleName, nil)
+	return err
+}
+
+// removeRuleTaint removes the rule's taint from a node, whichever step of the
+// escalation ladder it is at.
+func (r *RuleReadinessController) removeRuleTaint(ctx context.Context, node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule) error {
+	_, err := r.removeTaint(ctx, node, rule.Spec.Taint.Key, rule.Spec.GetTaintEffects(), rule.Name, nil)
 	return err
 }
 
@@ -342,9 +518,9 @@ func (r *RuleReadinessController) removeTaintAndCompleteBootstrap(ctx context.Co
 	log := ctrl.LoggerFrom(ctx)
 
 	annotations := map[string]string{
-		bootstrapAnnotationKey(rule.GetUID()): bootstrapAnnotationValue(rule.Name),
+		bootstrapAnnotationKey(rule.GetUID()): nodeBootstrapAnnotationValue(rule, node),
 	}
-	marked, err := r.removeTaint(ctx, node, rule.Spec.Taint, rule.Name, annotations)
+	marked, err := r.removeTaint(ctx, node, rule.Spec.Taint.Key, rule.Spec.GetTaintEffects(), rule.Name, annotations)
 	if err != nil {
 		return err
 	}
@@ -355,15 +531,16 @@ func (r *RuleReadinessController) removeTaintAndCompleteBootstrap(ctx context.Co
 	return nil
 }
 
-// removeTaint removes taintSpec from the node and sets any of the given
-// annotations if not already present atomically in the same patch
+// removeTaint removes the taint with the given key and any of the given
+// effects from the node and sets any of the given annotations if not already
+// present atomically in the same patch.
 // It returns whether any annotation was newly written.
 // We use client.MergeFromWithOptimisticLock because patching a list with a
 // JSON merge patch can cause races due to the fact that it fully replaces
 // the list on a change. Optimistic locking ensures the patch fails with a
 // conflict error if the node was modified concurrently, allowing the
 // controller to retry with fresh state.
-func (r *RuleReadinessController) removeTaint(ctx context.Context, node *corev1.Node, taintSpec corev1.Taint, ruleName string, annotations map[string]string) (bool, error) {
+func (r *RuleReadinessController) removeTaint(ctx context.Context, node *corev1.Node, key string, effects []corev1.TaintEffect, ruleName string, annotations map[string]string) (bool, error) {
 	hasNewAnnotations := false
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		// Fetch latest node state
@@ -372,7 +549,17 @@ func (r *RuleReadinessController) removeTaint(ctx context.Context, node *corev1.
 			return err
 		}
 
-		hasTaint := r.hasTaintBySpec(latestNode, taintSpec)
+		var removed []corev1.Taint
+		var newTaints []corev1.Taint
+		for _, taint := range latestNode.Spec.Taints {
+			if taint.Key == key && slices.Contains(effects, taint.Effect) {
+				removed = append(removed, taint)
+			} else {
+				newTaints = append(newTaints, taint)
+			}
+		}
+		hasTaint := len(removed) > 0
+
 		var missing []string
 		for key := range annotations {
 			if _, exists := latestNode.Annotations[key]; !exists {
@@ -388,12 +575,6 @@ func (r *RuleReadinessController) removeTaint(ctx context.Context, node *corev1.
 
 		stored := latestNode.DeepCopy()
 		if hasTaint {
-			var newTaints []corev1.Taint
-			for _, taint := range latestNode.Spec.Taints {
-				if taint.Key != taintSpec.Key || taint.Effect != taintSpec.Effect {
-					newTaints = append(newTaints, taint)
-				}
-			}
 			latestNode.Spec.Taints = newTaints
 		}
 		if latestNode.Annotations == nil && hasNewAnnotations {
@@ -406,8 +587,8 @@ func (r *RuleReadinessController) removeTaint(ctx context.Context, node *corev1.
 			return err
 		}
 
-		if hasTaint {
-			message := fmt.Sprintf("Taint '%s:%s' removed by rule '%s'", taintSpec.Key, taintSpec.Effect, ruleName)
+		for _, taint := range removed {
+			message := fmt.Sprintf("Taint '%s:%s' removed by rule '%s'", taint.Key, taint.Effect, ruleName)
 			r.EventRecorder.Eventf(latestNode, nil, corev1.EventTypeNormal, "TaintRemoved", "RemoveTaint", "%s", message)
 		}
 
@@ -422,22 +603,37 @@ func (r *RuleReadinessController) removeTaint(ctx context.Context, node *corev1.
 	return hasNewAnnotations, nil
 }
 
+// bootstrapCompletionAnnotation returns the value of the rule's bootstrap
+// completion annotation on the node, under the UID-based key or, unless the
+// legacy fallback is disabled, the legacy name-based key.
+func (r *RuleReadinessController) bootstrapCompletionAnnotation(node *corev1.Node, ruleName string, ruleUID types.UID) (string, bool) {
+	if value, exists := node.Annotations[bootstrapAnnotationKey(ruleUID)]; exists {
+		return value, true
+	}
+	if r.DisableLegacyBootstrapAnnotations {
+		return "", false
+	}
+	value, exists := node.Annotations[legacyBootstrapAnnotationKey(ruleName)]
+	return value, exists
+}
+
 // nodeHasBootstrapAnnotation reports whether the given node object carries
-// the rule's bootstrap completion annotation (UID-based or legacy key).
-func nodeHasBootstrapAnnotation(node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule) bool {
-	_, existsNew := node.Annotations[bootstrapAnnotationKey(rule.GetUID())]
-	_, existsLegacy := node.Annotations[legacyBootstrapAnnotationKey(rule.Name)]
-	return existsNew || existsLegacy
+// the rule's bootstrap completion annotation (UID-based or legacy key), or one
+// of a previous rule that the rule adopts.
+func (r *RuleReadinessController) nodeHasBootstrapAnnotation(node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule) bool {
+	_, exists := r.bootstrapCompletionAnnotation(node, rule.Name, rule.GetUID())
+	_, _, adoptable := adoptableBootstrapAnnotation(node, rule)
+	return exists || adoptable
 }
 
-func (r *RuleReadinessController) isBootstrapCompleted(ctx context.Context, nodeName string, ruleName string, ruleUID types.UID) bool {
+// isBootstrapCompleted reports whether the latest state of the node records
+// the rule's bootstrap completion, including one the rule is about to adopt.
+func (r *RuleReadinessController) isBootstrapCompleted(ctx context.Context, nodeName string, rule *readinessv1alpha1.NodeReadinessRule) bool {
 	node := &corev1.Node{}
 	if err := r.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
 		return false
 	}
-	_, existsNew := node.Annotations[bootstrapAnnotationKey(ruleUID)]
-	_, existsLegacy := node.Annotations[legacyBootstrapAnnotationKey(ruleName)]
-	return existsNew || existsLegacy
+	return r.nodeHasBootstrapAnnotation(node, rule)
 }
 
 // markBootstrapCompleted records bootstrap completion for a rule when a
@@ -461,7 +657,7 @@ func (r *RuleReadinessController) markBootstrapCompleted(ctx context.Context, no
 			return nil
 		}
 
-		if r.hasTaintBySpec(node, rule.Spec.Taint) {
+		if r.hasRuleTaint(node, rule) {
 			deferred = true
 			return nil
 		}
@@ -474,7 +670,7 @@ func (r *RuleReadinessController) markBootstrapCompleted(ctx context.Context, no
 			node.Annotations = make(map[string]string)
 		}
 
-		node.Annotations[annotationKey] = bootstrapAnnotationValue(rule.Name)
+		node.Annotations[annotationKey] = nodeBootstrapAnnotationValue(rule, node)
 		if err := r.Patch(ctx, node, patch); err != nil {
 			return err
 		}
@@ -534,10 +730,12 @@ func (r *RuleReadinessController) clearNodeFailure(rule *readinessv1alpha1.NodeR
 
 // SyncNodeStateMetrics synchronizes the NodesByState Prometheus metrics with the current rule status.
 func (r *RuleReadinessController) SyncNodeStateMetrics(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule) {
-	var ready, notReady, bootstrapping float64
+	var ready, notReady, bootstrapping, quarantined float64
 
 	for _, eval := range rule.Status.NodeEvaluations {
-		if eval.TaintStatus == readinessv1alpha1.TaintStatusAbsent {
+		if isQuarantined(eval.Flap) {
+			quarantined++
+		} else if eval.TaintStatus == readinessv1alpha1.TaintStatusAbsent {
 			ready++
 		} else {
 			// The taint is still present.
@@ -553,4 +751,5 @@ func (r *RuleReadinessController) SyncNodeStateMetrics(ctx context.Context, rule
 	metrics.NodesByState.WithLabelValues(rule.Name, string(metrics.NodeStateReady)).Set(ready)
 	metrics.NodesByState.WithLabelValues(rule.Name, string(metrics.NodeStateNotReady)).Set(notReady)
 	metrics.NodesByState.WithLabelValues(rule.Name, string(metrics.NodeStateBootstrapping)).Set(bootstrapping)
+	metrics.NodesByState.WithLabelValues(rule.Name, string(metrics.NodeStateQuarantined)).Set(quarantined)
 }
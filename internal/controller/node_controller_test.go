@@ -112,6 +112,7 @@ var _ = Describe("Node Controller", func() {
 			ctx                 context.Context
 			readinessController *RuleReadinessController
 			node                *corev1.Node
+			rule                *nodereadinessiov1alpha1.NodeReadinessRule
 			ruleUID             types.UID
 			ruleName            string
 			nodeName            string
@@ -122,6 +123,7 @@ var _ = Describe("Node Controller", func() {
 			ruleUID = types.UID("test-rule-uid-1234")
 			ruleName = "test-rule"
 			nodeName = "bootstrap-test-node"
+			rule = &nodereadinessiov1alpha1.NodeReadinessRule{ObjectMeta: metav1.ObjectMeta{Name: ruleName, UID: ruleUID}}
 
 			readinessController = &RuleReadinessController{
 				Client: k8sClient,
@@ -140,7 +142,7 @@ var _ = Describe("Node Controller", func() {
 		})
 
 		It("should return false if no annotations exist", func() {
-			Expect(readinessController.isBootstrapCompleted(ctx, nodeName, ruleName, ruleUID)).To(BeFalse())
+			Expect(readinessController.isBootstrapCompleted(ctx, nodeName, rule)).To(BeFalse())
 		})
 
 		It("should return true if only new annotation exists", func() {
@@ -151,7 +153,7 @@ var _ = Describe("Node Controller", func() {
 			}
 			Expect(k8sClient.Update(ctx, updatedNode)).To(Succeed())
 
-			Expect(readinessController.isBootstrapCompleted(ctx, nodeName, ruleName, ruleUID)).To(BeTrue())
+			Expect(readinessController.isBootstrapCompleted(ctx, nodeName, rule)).To(BeTrue())
 		})
 
 		It("should return true if only legacy annotation exists", func() {
@@ -162,7 +164,7 @@ var _ = Describe("Node Controller", func() {
 			}
 			Expect(k8sClient.Update(ctx, updatedNode)).To(Succeed())
 
-			Expect(readinessController.isBootstrapCompleted(ctx, nodeName, ruleName, ruleUID)).To(BeTrue())
+			Expect(readinessController.isBootstrapCompleted(ctx, nodeName, rule)).To(BeTrue())
 		})
 
 		It("should return true if both annotations exist", func() {
@@ -174,7 +176,7 @@ var _ = Describe("Node Controller", func() {
 			}
 			Expect(k8sClient.Update(ctx, updatedNode)).To(Succeed())
 
-			Expect(readinessController.isBootstrapCompleted(ctx, nodeName, ruleName, ruleUID)).To(BeTrue())
+			Expect(readinessController.isBootstrapCompleted(ctx, nodeName, rule)).To(BeTrue())
 		})
 	})
 
@@ -1288,7 +1290,7 @@ var _ = Describe("Node Controller", func() {
 			_ = metrics.Failures.WithLabelValues(rule.Name, string(metrics.FailureReasonEvaluationError)).Write(beforeM)
 			before := beforeM.GetCounter().GetValue()
 
-			err := controller.processNodeAgainstAllRules(ctx, node)
+			_, err := controller.processNodeAgainstAllRules(ctx, node)
 			Expect(err).To(HaveOccurred())
 
 			afterM := &dto.Metric{}
@@ -1351,7 +1353,7 @@ var _ = Describe("Node Controller", func() {
 			// Pre-condition: the stale failure must exist
 			Expect(rule.Status.FailedNodes).To(HaveLen(1))
 
-			err := controller.processNodeAgainstAllRules(ctx, node)
+			_, err := controller.processNodeAgainstAllRules(ctx, node)
 			Expect(err).ToNot(HaveOccurred())
 
 			// Also verify via the API server that the patched status has no stale failures
@@ -0,0 +1,149 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// nodeCount is what a Node contributes to a rule's node counts.
+type nodeCount struct {
+	matched, held, released, failed, bootstrapCompleted int32
+}
+
+// countRuleNodes counts the nodes of the list the rule is enforced on. Taints
+// and bootstrap completions are read from the nodes themselves, evaluations and
+// failures from the rule's status entries.
+func (r *RuleReadinessController) countRuleNodes(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeList *corev1.NodeList) nodeCount {
+	var total nodeCount
+	selector, err := metav1.LabelSelectorAsSelector(&rule.Spec.NodeSelector)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Invalid node selector for rule", "rule", rule.Name)
+		return total
+	}
+
+	entries := make(map[string]readinessv1alpha1.RuleEvaluation, len(rule.Status.NodeEvaluations))
+	for _, eval := range rule.Status.NodeEvaluations {
+		entry := entries[eval.NodeName]
+		entry.Evaluation = eval
+		entries[eval.NodeName] = entry
+	}
+	for _, failure := range rule.Status.FailedNodes {
+		entry := entries[failure.NodeName]
+		entry.Failure = failure
+		entries[failure.NodeName] = entry
+	}
+
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if !selector.Matches(labels.Set(node.Labels)) || !inRolloutSubset(rule, node.Name) {
+			continue
+		}
+		total = total.add(r.nodeCountOf(rule, node, entries[node.Name]))
+	}
+	return total
+}
+
+// nodeCountOf returns what the node contributes to the rule's node counts
+// given its entries in the rule's status, for a node the rule is enforced on.
+// Without the node, e.g. once it is deleted, the taint status recorded in its
+// evaluation is counted instead of its taints.
+func (r *RuleReadinessController) nodeCountOf(rule *readinessv1alpha1.NodeReadinessRule, node *corev1.Node, entry readinessv1alpha1.RuleEvaluation) nodeCount {
+	var count nodeCount
+	if entry.Failure.NodeName != "" {
+		count.failed++
+	}
+	if entry.Evaluation.NodeName == "" {
+		return count
+	}
+	count.matched++
+	if node == nil {
+		if entry.Evaluation.TaintStatus == readinessv1alpha1.TaintStatusPresent {
+			count.held++
+		} else {
+			count.released++
+		}
+		return count
+	}
+	if r.hasRuleTaint(node, rule) {
+		count.held++
+	} else {
+		count.released++
+	}
+	if rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly && r.nodeHasBootstrapAnnotation(node, rule) {
+		count.bootstrapCompleted++
+	}
+	return count
+}
+
+// add returns the sum of the counts.
+func (c nodeCount) add(other nodeCount) nodeCount {
+	return nodeCount{
+		matched:            c.matched + other.matched,
+		held:               c.held + other.held,
+		released:           c.released + other.released,
+		failed:             c.failed + other.failed,
+		bootstrapCompleted: c.bootstrapCompleted + other.bootstrapCompleted,
+	}
+}
+
+// sub returns the change from the other count to this one.
+func (c nodeCount) sub(other nodeCount) nodeCount {
+	return nodeCount{
+		matched:            c.matched - other.matched,
+		held:               c.held - other.held,
+		released:           c.released - other.released,
+		failed:             c.failed - other.failed,
+		bootstrapCompleted: c.bootstrapCompleted - other.bootstrapCompleted,
+	}
+}
+
+// addRuleNodeCounts applies the change of a single node to the counts in the
+// rule's status. The rule reconciler recounts all the nodes, which corrects
+// any drift of counts maintained this way.
+func addRuleNodeCounts(rule *readinessv1alpha1.NodeReadinessRule, delta nodeCount) {
+	counts := rule.Status.NodeCounts
+	setRuleNodeCounts(rule, nodeCount{
+		matched:            max(ptr.Deref(counts.Matched, 0)+delta.matched, 0),
+		held:               max(ptr.Deref(counts.Held, 0)+delta.held, 0),
+		released:           max(ptr.Deref(counts.Released, 0)+delta.released, 0),
+		failed:             max(ptr.Deref(counts.Failed, 0)+delta.failed, 0),
+		bootstrapCompleted: max(ptr.Deref(counts.BootstrapCompleted, 0)+delta.bootstrapCompleted, 0),
+	})
+}
+
+// setRuleNodeCounts records the counts in the rule's status.
+func setRuleNodeCounts(rule *readinessv1alpha1.NodeReadinessRule, count nodeCount) {
+	counts := readinessv1alpha1.RuleNodeCounts{
+		Matched:  ptr.To(count.matched),
+		Held:     ptr.To(count.held),
+		Released: ptr.To(count.released),
+		Failed:   ptr.To(count.failed),
+	}
+	if rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly {
+		counts.BootstrapCompleted = ptr.To(count.bootstrapCompleted)
+	}
+	rule.Status.NodeCounts = counts
+}
@@ -0,0 +1,136 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// countedRule returns the gpu rule holding its taint on node-1, released on
+// node-2 and failing on node-3, along with the nodes and node counts to match.
+func countedRule(r *RuleReadinessController) (*readinessv1alpha1.NodeReadinessRule, *corev1.NodeList) {
+	rule := gpuRule()
+	rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{
+		{NodeName: "node-1", TaintStatus: readinessv1alpha1.TaintStatusPresent},
+		{NodeName: "node-2", TaintStatus: readinessv1alpha1.TaintStatusAbsent},
+	}
+	rule.Status.FailedNodes = []readinessv1alpha1.NodeFailure{{NodeName: "node-3", Reason: "EvaluationError"}}
+	nodeList := &corev1.NodeList{Items: []corev1.Node{
+		*gpuNode("node-1", true), *gpuNode("node-2", false), *gpuNode("node-3", false),
+	}}
+	setRuleNodeCounts(rule, r.countRuleNodes(context.Background(), rule, nodeList))
+	return rule, nodeList
+}
+
+func TestCountRuleNodes(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	r := &RuleReadinessController{}
+	rule, nodeList := countedRule(r)
+	g.Expect(rule.Status.NodeCounts).To(Equal(readinessv1alpha1.RuleNodeCounts{
+		Matched:  ptr.To[int32](2),
+		Held:     ptr.To[int32](1),
+		Released: ptr.To[int32](1),
+		Failed:   ptr.To[int32](1),
+	}))
+
+	// Taints are read from the nodes rather than the evaluations.
+	nodeList.Items[0].Spec.Taints = nil
+	g.Expect(r.countRuleNodes(ctx, rule, nodeList)).To(Equal(nodeCount{matched: 2, released: 2, failed: 1}))
+
+	// Only the nodes the rule selects are counted.
+	nodeList.Items[1].Labels = nil
+	g.Expect(r.countRuleNodes(ctx, rule, nodeList)).To(Equal(nodeCount{matched: 1, released: 1, failed: 1}))
+}
+
+func TestProcessNodeAgainstAllRules_UpdatesNodeCounts(t *testing.T) {
+	for _, nodeStatusObjects := range []bool{false, true} {
+		t.Run(fmt.Sprintf("nodeStatusObjects=%t", nodeStatusObjects), func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+			node := gpuNode("node-0", true)
+			evaluated := []readinessv1alpha1.NodeEvaluation{
+				{NodeName: node.Name, TaintStatus: readinessv1alpha1.TaintStatusPresent},
+			}
+			rule := gpuRule()
+			// The other nodes are only known through the counts recorded by
+			// the rule reconciler, which are not recounted.
+			rule.Status.NodeCounts = readinessv1alpha1.RuleNodeCounts{
+				Matched: ptr.To[int32](10), Held: ptr.To[int32](10), Released: ptr.To[int32](0), Failed: ptr.To[int32](0),
+			}
+			if !nodeStatusObjects {
+				rule.Status.NodeEvaluations = evaluated
+			}
+			r := newNodeStatusController(t, node, rule)
+			r.NodeStatusObjects = nodeStatusObjects
+			r.EventRecorder = events.NewFakeRecorder(10)
+			r.ruleCache = map[string]*readinessv1alpha1.NodeReadinessRule{rule.Name: rule.DeepCopy()}
+			if nodeStatusObjects {
+				stored := gpuRule()
+				stored.Status.NodeEvaluations = evaluated
+				g.Expect(r.storeNodeStatus(ctx, stored, node)).To(Succeed())
+			}
+
+			// The rule has no conditions, so node-0 is released.
+			_, err := r.processNodeAgainstAllRules(ctx, node)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			latest := &readinessv1alpha1.NodeReadinessRule{}
+			g.Expect(r.Get(ctx, client.ObjectKey{Name: rule.Name}, latest)).To(Succeed())
+			g.Expect(latest.Status.NodeCounts).To(Equal(readinessv1alpha1.RuleNodeCounts{
+				Matched:  ptr.To[int32](10),
+				Held:     ptr.To[int32](9),
+				Released: ptr.To[int32](1),
+				Failed:   ptr.To[int32](0),
+			}))
+		})
+	}
+}
+
+func TestRemoveNodeFromRuleStatus_UpdatesNodeCounts(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	// node-1 was deleted.
+	rule, nodeList := countedRule(&RuleReadinessController{})
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).
+		WithObjects(rule, &nodeList.Items[1], &nodeList.Items[2]).WithStatusSubresource(rule).Build()
+	r := &RuleReadinessController{Client: fc}
+
+	patched, err := r.removeNodeFromRuleStatus(ctx, rule.Name, "node-1")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(patched).NotTo(BeNil())
+
+	latest := &readinessv1alpha1.NodeReadinessRule{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: rule.Name}, latest)).To(Succeed())
+	g.Expect(latest.Status.NodeCounts).To(Equal(readinessv1alpha1.RuleNodeCounts{
+		Matched:  ptr.To[int32](1),
+		Held:     ptr.To[int32](0),
+		Released: ptr.To[int32](1),
+		Failed:   ptr.To[int32](1),
+	}))
+}
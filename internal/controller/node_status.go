@@ -0,0 +1,304 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/metrics"
+)
+
+// +kubebuilder:rbac:groups=readiness.node.x-k8s.io,resources=nodereadinessstatuses,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=readiness.node.x-k8s.io,resources=nodereadinessstatuses/status,verbs=get;update;patch
+
+// When NodeStatusObjects is set, the per-node detail of a rule's status is
+// recorded in NodeReadinessStatus objects, one per Node, rather than in the
+// rule's status. The controller loads it into the rule's nodeEvaluations and
+// failedNodes while reconciling, so that the evaluation logic is the same in
+// both cases, and stores each Node's entries back once evaluated.
+
+// ruleEvaluationFor returns the rule's entries for the node in its status.
+func ruleEvaluationFor(rule *readinessv1alpha1.NodeReadinessRule, nodeName string) readinessv1alpha1.RuleEvaluation {
+	entry := readinessv1alpha1.RuleEvaluation{RuleName: rule.Name}
+	for _, eval := range rule.Status.NodeEvaluations {
+		if eval.NodeName == nodeName {
+			entry.Evaluation = eval
+			break
+		}
+	}
+	for _, failure := range rule.Status.FailedNodes {
+		if failure.NodeName == nodeName {
+			entry.Failure = failure
+			break
+		}
+	}
+	return entry
+}
+
+// setRuleEvaluation replaces the rule's entries for the node in its status.
+func setRuleEvaluation(rule *readinessv1alpha1.NodeReadinessRule, nodeName string, entry readinessv1alpha1.RuleEvaluation) {
+	rule.Status.NodeEvaluations = slices.DeleteFunc(rule.Status.NodeEvaluations, func(eval readinessv1alpha1.NodeEvaluation) bool {
+		return eval.NodeName == nodeName
+	})
+	if entry.Evaluation.NodeName != "" {
+		rule.Status.NodeEvaluations = append(rule.Status.NodeEvaluations, entry.Evaluation)
+	}
+	rule.Status.FailedNodes = slices.DeleteFunc(rule.Status.FailedNodes, func(failure readinessv1alpha1.NodeFailure) bool {
+		return failure.NodeName == nodeName
+	})
+	if entry.Failure.NodeName != "" {
+		rule.Status.FailedNodes = append(rule.Status.FailedNodes, entry.Failure)
+	}
+}
+
+// findRuleEvaluation returns the rule's entry in the node status, or nil.
+func findRuleEvaluation(status *readinessv1alpha1.NodeReadinessStatus, ruleName string) *readinessv1alpha1.RuleEvaluation {
+	for i := range status.Status.Rules {
+		if status.Status.Rules[i].RuleName == ruleName {
+			return &status.Status.Rules[i]
+		}
+	}
+	return nil
+}
+
+// loadRuleNodeStatuses loads the per-node detail of the rule's status from
+// the NodeReadinessStatus objects. Entries still recorded inline in the
+// rule's status only are kept, and the names of their nodes returned so that
+// they can be migrated.
+func (r *RuleReadinessController) loadRuleNodeStatuses(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule) ([]string, error) {
+	statusList := &readinessv1alpha1.NodeReadinessStatusList{}
+	if err := r.List(ctx, statusList); err != nil {
+		return nil, fmt.Errorf("failed to list node readiness statuses: %w", err)
+	}
+
+	// Each node has at most one object and one inline entry of each kind, so
+	// the entries are appended rather than set one node at a time.
+	inline := rule.DeepCopy()
+	rule.Status.NodeEvaluations = nil
+	rule.Status.FailedNodes = nil
+	recorded := make(map[string]bool, len(statusList.Items))
+	for i := range statusList.Items {
+		if entry := findRuleEvaluation(&statusList.Items[i], rule.Name); entry != nil {
+			recorded[statusList.Items[i].Name] = true
+			if entry.Evaluation.NodeName != "" {
+				rule.Status.NodeEvaluations = append(rule.Status.NodeEvaluations, entry.Evaluation)
+			}
+			if entry.Failure.NodeName != "" {
+				rule.Status.FailedNodes = append(rule.Status.FailedNodes, entry.Failure)
+			}
+		}
+	}
+
+	for _, eval := range inline.Status.NodeEvaluations {
+		if !recorded[eval.NodeName] {
+			rule.Status.NodeEvaluations = append(rule.Status.NodeEvaluations, eval)
+		}
+	}
+	for _, failure := range inline.Status.FailedNodes {
+		if !recorded[failure.NodeName] {
+			rule.Status.FailedNodes = append(rule.Status.FailedNodes, failure)
+		}
+	}
+
+	var unmigrated []string
+	for _, nodeName := range ruleStatusNodeNames(inline) {
+		if !recorded[nodeName] {
+			unmigrated = append(unmigrated, nodeName)
+		}
+	}
+	return unmigrated, nil
+}
+
+// loadNodeStatus loads the rule's entries for the node from its
+// NodeReadinessStatus object, unless they are still recorded inline in the
+// rule's status only.
+func (r *RuleReadinessController) loadNodeStatus(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeName string) error {
+	status := &readinessv1alpha1.NodeReadinessStatus{}
+	if err := r.Get(ctx, client.ObjectKey{Name: nodeName}, status); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if entry := findRuleEvaluation(status, rule.Name); entry != nil {
+		setRuleEvaluation(rule, nodeName, *entry)
+	}
+	return nil
+}
+
+// storeNodeStatus records the rule's entries for the node in the node's
+// NodeReadinessStatus object, creating it owned by the node if needed, along
+// with the transitions from the previously recorded entries.
+func (r *RuleReadinessController) storeNodeStatus(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, node *corev1.Node) error {
+	entry := ruleEvaluationFor(rule, node.Name)
+	if entry.Evaluation.NodeName == "" && entry.Failure.NodeName == "" {
+		return r.removeNodeStatus(ctx, rule.Name, node.Name)
+	}
+
+	var transitions []readinessv1alpha1.NodeTransition
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		transitions = nil
+		status := &readinessv1alpha1.NodeReadinessStatus{}
+		err := r.Get(ctx, client.ObjectKey{Name: node.Name}, status)
+		if apierrors.IsNotFound(err) {
+			status = &readinessv1alpha1.NodeReadinessStatus{ObjectMeta: metav1.ObjectMeta{Name: node.Name}}
+			if err := controllerutil.SetOwnerReference(node, status, r.Scheme); err != nil {
+				return err
+			}
+			if err := r.Create(ctx, status); err != nil {
+				if apierrors.IsAlreadyExists(err) {
+					// The cache has not seen the object yet; retry once it has.
+					return apierrors.NewConflict(readinessv1alpha1.GroupVersion.WithResource("nodereadinessstatuses").GroupResource(), node.Name, err)
+				}
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+
+		current := findRuleEvaluation(status, rule.Name)
+		updated := entry
+		// The transitions recorded in the rule's status before its entries
+		// were migrated carry over to the object.
+		updated.Transitions = entry.Evaluation.Transitions
+		updated.Evaluation.Transitions = nil
+		if current != nil {
+			updated.Transitions = current.Transitions
+		}
+		transitions = nodeTransitions(current, entry, metav1.Now())
+		updated.Transitions = appendNodeTransitions(updated.Transitions, transitions, maxNodeTransitions)
+		if current != nil && equality.Semantic.DeepEqual(*current, updated) {
+			return nil
+		}
+
+		patch := client.MergeFromWithOptions(status.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		status.Status.Rules = slices.DeleteFunc(status.Status.Rules, func(e readinessv1alpha1.RuleEvaluation) bool {
+			return e.RuleName == rule.Name
+		})
+		status.Status.Rules = append(status.Status.Rules, updated)
+		return r.Status().Patch(ctx, status, patch)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, transition := range transitions {
+		metrics.NodeTransitions.WithLabelValues(rule.Name, string(transition.Type)).Inc()
+	}
+	return nil
+}
+
+// removeNodeStatus drops the rule's entries from the node's
+// NodeReadinessStatus object, and deletes the object once no rule has
+// entries in it.
+func (r *RuleReadinessController) removeNodeStatus(ctx context.Context, ruleName, nodeName string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		status := &readinessv1alpha1.NodeReadinessStatus{}
+		if err := r.Get(ctx, client.ObjectKey{Name: nodeName}, status); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if findRuleEvaluation(status, ruleName) == nil {
+			return nil
+		}
+
+		if len(status.Status.Rules) == 1 {
+			return client.IgnoreNotFound(r.Delete(ctx, status, client.Preconditions{ResourceVersion: &status.ResourceVersion}))
+		}
+		patch := client.MergeFromWithOptions(status.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		status.Status.Rules = slices.DeleteFunc(status.Status.Rules, func(e readinessv1alpha1.RuleEvaluation) bool {
+			return e.RuleName == ruleName
+		})
+		return r.Status().Patch(ctx, status, patch)
+	})
+}
+
+// removeRuleNodeStatuses drops the rule's entries from all
+// NodeReadinessStatus objects.
+func (r *RuleReadinessController) removeRuleNodeStatuses(ctx context.Context, ruleName string) error {
+	statusList := &readinessv1alpha1.NodeReadinessStatusList{}
+	if err := r.List(ctx, statusList); err != nil {
+		return fmt.Errorf("failed to list node readiness statuses: %w", err)
+	}
+
+	var errs []error
+	for i := range statusList.Items {
+		if findRuleEvaluation(&statusList.Items[i], ruleName) == nil {
+			continue
+		}
+		if err := r.removeNodeStatus(ctx, ruleName, statusList.Items[i].Name); err != nil {
+			errs = append(errs, fmt.Errorf("node %s: %w", statusList.Items[i].Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// migrateInlineNodeStatuses stores the entries of the named nodes, which are
+// still recorded inline in the rule's status only, in their
+// NodeReadinessStatus objects. Entries of nodes that no longer exist are
+// dropped.
+func (r *RuleReadinessController) migrateInlineNodeStatuses(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeNames []string, nodeList *corev1.NodeList) error {
+	var errs []error
+	for _, nodeName := range nodeNames {
+		i := slices.IndexFunc(nodeList.Items, func(node corev1.Node) bool { return node.Name == nodeName })
+		if i < 0 {
+			continue
+		}
+		if err := r.storeNodeStatus(ctx, rule, &nodeList.Items[i]); err != nil {
+			errs = append(errs, fmt.Errorf("node %s: %w", nodeName, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ruleStatusNodeNames returns the names of the nodes the rule's status has
+// entries for.
+func ruleStatusNodeNames(rule *readinessv1alpha1.NodeReadinessRule) []string {
+	var names []string
+	seen := make(map[string]bool, len(rule.Status.NodeEvaluations))
+	for _, eval := range rule.Status.NodeEvaluations {
+		names = append(names, eval.NodeName)
+		seen[eval.NodeName] = true
+	}
+	for _, failure := range rule.Status.FailedNodes {
+		if !seen[failure.NodeName] {
+			names = append(names, failure.NodeName)
+		}
+	}
+	return names
+}
+
+// syncRuleNodeStateMetrics syncs the node state metrics of a rule patched by
+// the node reconciler, loading the per-node detail of its status first.
+func (r *RuleReadinessController) syncRuleNodeStateMetrics(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule) {
+	if r.NodeStatusObjects {
+		if _, err := r.loadRuleNodeStatuses(ctx, rule); err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Failed to load node statuses", "rule", rule.Name)
+			return
+		}
+	}
+	r.SyncNodeStateMetrics(ctx, rule)
+}
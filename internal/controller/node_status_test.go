@@ -0,0 +1,126 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+func newNodeStatusController(t *testing.T, objs ...client.Object) *RuleReadinessController {
+	scheme := newTestScheme(t)
+	fc := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+		WithStatusSubresource(&readinessv1alpha1.NodeReadinessRule{}, &readinessv1alpha1.NodeReadinessStatus{}).Build()
+	return &RuleReadinessController{Client: fc, Scheme: scheme, NodeStatusObjects: true}
+}
+
+func TestStoreNodeStatus(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	node := gpuNode("node-1", true)
+	r := newNodeStatusController(t, node)
+
+	rule := gpuRule()
+	rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{
+		{NodeName: "node-1", TaintStatus: readinessv1alpha1.TaintStatusPresent},
+	}
+	g.Expect(r.storeNodeStatus(ctx, rule, node)).To(Succeed())
+
+	status := &readinessv1alpha1.NodeReadinessStatus{}
+	g.Expect(r.Get(ctx, client.ObjectKey{Name: "node-1"}, status)).To(Succeed())
+	g.Expect(status.OwnerReferences).To(HaveLen(1))
+	g.Expect(status.OwnerReferences[0].Kind).To(Equal("Node"))
+	g.Expect(status.OwnerReferences[0].Name).To(Equal("node-1"))
+	g.Expect(status.Status.Rules).To(HaveLen(1))
+	g.Expect(status.Status.Rules[0].RuleName).To(Equal(rule.Name))
+	g.Expect(status.Status.Rules[0].Evaluation.TaintStatus).To(Equal(readinessv1alpha1.TaintStatusPresent))
+
+	// The entries are loaded back into the rule's status.
+	loaded := gpuRule()
+	unmigrated, err := r.loadRuleNodeStatuses(ctx, loaded)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(unmigrated).To(BeEmpty())
+	g.Expect(loaded.Status.NodeEvaluations).To(Equal(rule.Status.NodeEvaluations))
+
+	// Dropping the rule's last entries deletes the object.
+	g.Expect(r.removeNodeStatus(ctx, rule.Name, "node-1")).To(Succeed())
+	err = r.Get(ctx, client.ObjectKey{Name: "node-1"}, status)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestMigrateInlineNodeStatuses(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	node := gpuNode("node-1", true)
+	r := newNodeStatusController(t, node)
+
+	// node-2 no longer exists; its entries are dropped.
+	rule := gpuRule()
+	rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{
+		{NodeName: "node-1", TaintStatus: readinessv1alpha1.TaintStatusPresent},
+		{NodeName: "node-2", TaintStatus: readinessv1alpha1.TaintStatusAbsent},
+	}
+	rule.Status.FailedNodes = []readinessv1alpha1.NodeFailure{{NodeName: "node-1", Reason: "EvaluationError"}}
+
+	unmigrated, err := r.loadRuleNodeStatuses(ctx, rule)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(unmigrated).To(ConsistOf("node-1", "node-2"))
+	g.Expect(r.migrateInlineNodeStatuses(ctx, rule, unmigrated, &corev1.NodeList{Items: []corev1.Node{*node}})).To(Succeed())
+
+	status := &readinessv1alpha1.NodeReadinessStatus{}
+	g.Expect(r.Get(ctx, client.ObjectKey{Name: "node-1"}, status)).To(Succeed())
+	g.Expect(status.Status.Rules).To(HaveLen(1))
+	g.Expect(status.Status.Rules[0].Evaluation.NodeName).To(Equal("node-1"))
+	g.Expect(status.Status.Rules[0].Failure.Reason).To(Equal("EvaluationError"))
+	err = r.Get(ctx, client.ObjectKey{Name: "node-2"}, status)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	// Once stored, the entries are no longer reported as unmigrated.
+	inline := gpuRule()
+	inline.Status.NodeEvaluations = rule.Status.NodeEvaluations[:1]
+	unmigrated, err = r.loadRuleNodeStatuses(ctx, inline)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(unmigrated).To(BeEmpty())
+}
+
+func TestRemoveNodeFromRuleStatus_NodeStatusObjects(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	node := gpuNode("node-1", true)
+	rule := gpuRule()
+	r := newNodeStatusController(t, node, rule)
+
+	rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{
+		{NodeName: "node-1", TaintStatus: readinessv1alpha1.TaintStatusPresent},
+	}
+	g.Expect(r.storeNodeStatus(ctx, rule, node)).To(Succeed())
+
+	patched, err := r.removeNodeFromRuleStatus(ctx, rule.Name, "node-1")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(patched).NotTo(BeNil())
+
+	status := &readinessv1alpha1.NodeReadinessStatus{}
+	err = r.Get(ctx, client.ObjectKey{Name: "node-1"}, status)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
@@ -0,0 +1,182 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/metrics"
+)
+
+const (
+	// maxNodeTransitions is the number of transitions kept for each Node and
+	// rule, matching the MaxItems of RuleEvaluation.Transitions.
+	maxNodeTransitions = 20
+
+	// maxInlineNodeTransitions is the number of transitions kept for each
+	// Node in a rule's status, matching the MaxItems of
+	// NodeEvaluation.Transitions.
+	maxInlineNodeTransitions = 5
+
+	// maxTransitionMessageLength matches the MaxLength of NodeTransition.Message.
+	maxTransitionMessageLength = 1024
+)
+
+// nodeTransitions returns the changes from the previously recorded entry of
+// a rule for a Node, if any, to the new one. The taint being held from the
+// first evaluation on is recorded as it being added.
+func nodeTransitions(previous *readinessv1alpha1.RuleEvaluation, entry readinessv1alpha1.RuleEvaluation, now metav1.Time) []readinessv1alpha1.NodeTransition {
+	var prev readinessv1alpha1.RuleEvaluation
+	if previous != nil {
+		prev = *previous
+	}
+
+	var transitions []readinessv1alpha1.NodeTransition
+	if failure := entry.Failure; failure.NodeName != "" && prev.Failure.NodeName == "" {
+		transitions = append(transitions, readinessv1alpha1.NodeTransition{
+			Type:    readinessv1alpha1.NodeTransitionEvaluationFailed,
+			Time:    transitionTime(failure.LastEvaluationTime, now),
+			Reason:  cmp.Or(failure.Reason, "EvaluationError"),
+			Message: truncateMessage(failure.Message),
+		})
+	} else if failure.NodeName == "" && prev.Failure.NodeName != "" {
+		transitions = append(transitions, readinessv1alpha1.NodeTransition{
+			Type:   readinessv1alpha1.NodeTransitionEvaluationRecovered,
+			Time:   transitionTime(entry.Evaluation.LastEvaluationTime, now),
+			Reason: "EvaluationSucceeded",
+		})
+	}
+
+	eval := entry.Evaluation
+	if eval.NodeName == "" {
+		return transitions
+	}
+	at := transitionTime(eval.LastEvaluationTime, now)
+
+	if prev.Evaluation.NodeName != "" {
+		previousStatus := make(map[string]readinessv1alpha1.ConditionEvaluationResult, len(prev.Evaluation.ConditionResults))
+		for _, result := range prev.Evaluation.ConditionResults {
+			previousStatus[result.Type] = result
+		}
+		for _, result := range eval.ConditionResults {
+			before, ok := previousStatus[result.Type]
+			if !ok || before.CurrentStatus == result.CurrentStatus {
+				continue
+			}
+			reason := "ConditionUnsatisfied"
+			if result.CurrentStatus == result.RequiredStatus {
+				reason = "ConditionSatisfied"
+			}
+			transitions = append(transitions, readinessv1alpha1.NodeTransition{
+				Type:            readinessv1alpha1.NodeTransitionConditionChanged,
+				Time:            at,
+				ConditionType:   result.Type,
+				ConditionStatus: result.CurrentStatus,
+				Reason:          reason,
+				Message:         truncateMessage(fmt.Sprintf("Condition %s changed from %s to %s", result.Type, before.CurrentStatus, result.CurrentStatus)),
+			})
+		}
+	}
+
+	if eval.TaintStatus == prev.Evaluation.TaintStatus ||
+		(prev.Evaluation.NodeName == "" && eval.TaintStatus != readinessv1alpha1.TaintStatusPresent) {
+		return transitions
+	}
+	transition := readinessv1alpha1.NodeTransition{Time: at}
+	unsatisfied := unsatisfiedConditions(eval)
+	switch {
+	case eval.TaintStatus == readinessv1alpha1.TaintStatusPresent:
+		transition.Type = readinessv1alpha1.NodeTransitionTaintAdded
+		transition.Reason = "TaintHeld"
+		if len(unsatisfied) > 0 {
+			transition.Reason = "ConditionsUnsatisfied"
+			transition.Message = "Unsatisfied conditions: " + strings.Join(unsatisfied, ", ")
+		}
+	default:
+		transition.Type = readinessv1alpha1.NodeTransitionTaintRemoved
+		transition.Reason = "ConditionsSatisfied"
+	}
+	if eval.Override.Action != "" {
+		transition.Reason = "Override"
+		transition.Message = fmt.Sprintf("Override %s from annotation %s", eval.Override.Action, eval.Override.Annotation)
+	}
+	transition.Message = truncateMessage(transition.Message)
+	return append(transitions, transition)
+}
+
+// appendNodeTransitions appends the new transitions to the recorded ones,
+// dropping the oldest beyond limit.
+func appendNodeTransitions(recorded, transitions []readinessv1alpha1.NodeTransition, limit int) []readinessv1alpha1.NodeTransition {
+	all := append(append([]readinessv1alpha1.NodeTransition(nil), recorded...), transitions...)
+	if len(all) > limit {
+		all = all[len(all)-limit:]
+	}
+	return all
+}
+
+// recordInlineNodeTransitions records the transitions of the rule's entries
+// for the node from the previous ones in its evaluation, when the per-node
+// detail is recorded in the rule's status. With NodeReadinessStatus objects,
+// storeNodeStatus records them instead.
+func (r *RuleReadinessController) recordInlineNodeTransitions(rule *readinessv1alpha1.NodeReadinessRule, nodeName string, previous readinessv1alpha1.RuleEvaluation) {
+	if r.NodeStatusObjects {
+		return
+	}
+	for i := range rule.Status.NodeEvaluations {
+		eval := &rule.Status.NodeEvaluations[i]
+		if eval.NodeName != nodeName {
+			continue
+		}
+		transitions := nodeTransitions(&previous, ruleEvaluationFor(rule, nodeName), metav1.Now())
+		eval.Transitions = appendNodeTransitions(eval.Transitions, transitions, maxInlineNodeTransitions)
+		for _, transition := range transitions {
+			metrics.NodeTransitions.WithLabelValues(rule.Name, string(transition.Type)).Inc()
+		}
+		return
+	}
+}
+
+// unsatisfiedConditions returns the conditions of the evaluation whose status
+// does not match the rule's requirement.
+func unsatisfiedConditions(eval readinessv1alpha1.NodeEvaluation) []string {
+	var unsatisfied []string
+	for _, result := range eval.ConditionResults {
+		if result.CurrentStatus != result.RequiredStatus {
+			unsatisfied = append(unsatisfied, fmt.Sprintf("%s=%s", result.Type, result.CurrentStatus))
+		}
+	}
+	return unsatisfied
+}
+
+func transitionTime(t, now metav1.Time) metav1.Time {
+	if t.IsZero() {
+		return now
+	}
+	return t
+}
+
+func truncateMessage(message string) string {
+	if len(message) <= maxTransitionMessageLength {
+		return message
+	}
+	return message[:maxTransitionMessageLength-3] + "..."
+}
@@ -0,0 +1,189 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// gpuEvaluation returns an evaluation of the gpu rule on node-1 with the
+// given condition status.
+func gpuEvaluation(status corev1.ConditionStatus, taintStatus readinessv1alpha1.TaintStatus) readinessv1alpha1.RuleEvaluation {
+	return readinessv1alpha1.RuleEvaluation{
+		RuleName: "gpu-ready",
+		Evaluation: readinessv1alpha1.NodeEvaluation{
+			NodeName: "node-1",
+			ConditionResults: []readinessv1alpha1.ConditionEvaluationResult{
+				{Type: "gpu-ready", CurrentStatus: status, RequiredStatus: corev1.ConditionTrue},
+			},
+			TaintStatus: taintStatus,
+		},
+	}
+}
+
+func TestNodeTransitions(t *testing.T) {
+	now := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	held := gpuEvaluation(corev1.ConditionFalse, readinessv1alpha1.TaintStatusPresent)
+	released := gpuEvaluation(corev1.ConditionTrue, readinessv1alpha1.TaintStatusAbsent)
+	failed := held
+	failed.Failure = readinessv1alpha1.NodeFailure{NodeName: "node-1", Reason: "EvaluationError", Message: "boom"}
+
+	tests := []struct {
+		name     string
+		previous *readinessv1alpha1.RuleEvaluation
+		entry    readinessv1alpha1.RuleEvaluation
+		want     []readinessv1alpha1.NodeTransition
+	}{
+		{
+			name:  "first evaluation holding the taint",
+			entry: held,
+			want: []readinessv1alpha1.NodeTransition{{
+				Type: readinessv1alpha1.NodeTransitionTaintAdded, Time: now,
+				Reason: "ConditionsUnsatisfied", Message: "Unsatisfied conditions: gpu-ready=False",
+			}},
+		},
+		{
+			name:  "first evaluation releasing the taint",
+			entry: released,
+		},
+		{
+			name:     "unchanged",
+			previous: &held,
+			entry:    held,
+		},
+		{
+			name:     "condition satisfied and taint removed",
+			previous: &held,
+			entry:    released,
+			want: []readinessv1alpha1.NodeTransition{
+				{
+					Type: readinessv1alpha1.NodeTransitionConditionChanged, Time: now,
+					ConditionType: "gpu-ready", ConditionStatus: corev1.ConditionTrue,
+					Reason: "ConditionSatisfied", Message: "Condition gpu-ready changed from False to True",
+				},
+				{Type: readinessv1alpha1.NodeTransitionTaintRemoved, Time: now, Reason: "ConditionsSatisfied"},
+			},
+		},
+		{
+			name:     "evaluation failed",
+			previous: &held,
+			entry:    failed,
+			want: []readinessv1alpha1.NodeTransition{{
+				Type: readinessv1alpha1.NodeTransitionEvaluationFailed, Time: now,
+				Reason: "EvaluationError", Message: "boom",
+			}},
+		},
+		{
+			name:     "evaluation recovered",
+			previous: &failed,
+			entry:    held,
+			want: []readinessv1alpha1.NodeTransition{{
+				Type: readinessv1alpha1.NodeTransitionEvaluationRecovered, Time: now, Reason: "EvaluationSucceeded",
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(nodeTransitions(tt.previous, tt.entry, now)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestAppendNodeTransitions(t *testing.T) {
+	g := NewWithT(t)
+	var recorded []readinessv1alpha1.NodeTransition
+	for i := range maxNodeTransitions + 5 {
+		recorded = appendNodeTransitions(recorded, []readinessv1alpha1.NodeTransition{
+			{Type: readinessv1alpha1.NodeTransitionTaintAdded, Reason: fmt.Sprintf("r%d", i)},
+		}, maxNodeTransitions)
+	}
+	g.Expect(recorded).To(HaveLen(maxNodeTransitions))
+	g.Expect(recorded[0].Reason).To(Equal("r5"))
+	g.Expect(recorded[maxNodeTransitions-1].Reason).To(Equal(fmt.Sprintf("r%d", maxNodeTransitions+4)))
+}
+
+func TestStoreNodeStatus_RecordsTransitions(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	node := gpuNode("node-1", true)
+	r := newNodeStatusController(t, node)
+
+	rule := gpuRule()
+	held := gpuEvaluation(corev1.ConditionFalse, readinessv1alpha1.TaintStatusPresent)
+	rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{held.Evaluation}
+	g.Expect(r.storeNodeStatus(ctx, rule, node)).To(Succeed())
+	g.Expect(r.storeNodeStatus(ctx, rule, node)).To(Succeed())
+
+	released := gpuEvaluation(corev1.ConditionTrue, readinessv1alpha1.TaintStatusAbsent)
+	rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{released.Evaluation}
+	g.Expect(r.storeNodeStatus(ctx, rule, node)).To(Succeed())
+
+	status := &readinessv1alpha1.NodeReadinessStatus{}
+	g.Expect(r.Get(ctx, client.ObjectKey{Name: "node-1"}, status)).To(Succeed())
+	g.Expect(status.Status.Rules).To(HaveLen(1))
+	var types []readinessv1alpha1.NodeTransitionType
+	for _, transition := range status.Status.Rules[0].Transitions {
+		types = append(types, transition.Type)
+	}
+	g.Expect(types).To(Equal([]readinessv1alpha1.NodeTransitionType{
+		readinessv1alpha1.NodeTransitionTaintAdded,
+		readinessv1alpha1.NodeTransitionConditionChanged,
+		readinessv1alpha1.NodeTransitionTaintRemoved,
+	}))
+}
+
+func TestProcessNodeAgainstAllRules_RecordsTransitionsInline(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	node := gpuNode("node-1", true)
+	rule := gpuRule()
+	rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{
+		{NodeName: node.Name, TaintStatus: readinessv1alpha1.TaintStatusPresent},
+	}
+	r := newNodeStatusController(t, node, rule)
+	r.NodeStatusObjects = false
+	r.EventRecorder = events.NewFakeRecorder(10)
+	r.ruleCache = map[string]*readinessv1alpha1.NodeReadinessRule{rule.Name: rule.DeepCopy()}
+
+	// The rule has no conditions, so node-1 is released.
+	_, err := r.processNodeAgainstAllRules(ctx, node)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	latest := &readinessv1alpha1.NodeReadinessRule{}
+	g.Expect(r.Get(ctx, client.ObjectKey{Name: rule.Name}, latest)).To(Succeed())
+	g.Expect(latest.Status.NodeEvaluations).To(HaveLen(1))
+	transitions := latest.Status.NodeEvaluations[0].Transitions
+	g.Expect(transitions).To(HaveLen(1))
+	g.Expect(transitions[0].Type).To(Equal(readinessv1alpha1.NodeTransitionTaintRemoved))
+	g.Expect(transitions[0].Reason).To(Equal("ConditionsSatisfied"))
+
+	// No NodeReadinessStatus object is created.
+	err = r.Get(ctx, client.ObjectKey{Name: node.Name}, &readinessv1alpha1.NodeReadinessStatus{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -29,8 +30,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -53,9 +56,39 @@ type RuleReadinessController struct {
 	client.Client
 	Scheme                 *runtime.Scheme
 	clientset              kubernetes.Interface
+	apiReader              client.Reader // reads from the API server, bypassing the cache
 	EventRecorder          events.EventRecorder
 	EnableNodeStateMetrics bool
 
+	// DisableLegacyBootstrapAnnotations stops honouring bootstrap completion
+	// annotations keyed by rule name, once they have all been migrated.
+	DisableLegacyBootstrapAnnotations bool
+
+	// NodeStatusObjects records the per-node detail of rule statuses in
+	// NodeReadinessStatus objects, leaving only aggregates in the rules.
+	NodeStatusObjects bool
+
+	// Eviction rate limiters of rules that drain nodes
+	evictionLimitersMutex sync.Mutex
+	evictionLimiters      map[string]flowcontrol.RateLimiter // ruleName -> limiter
+
+	// Rate limiter for removing bootstrap completion annotations of deleted rules
+	bootstrapAnnotationGCOnce        sync.Once
+	bootstrapAnnotationGCRateLimiter flowcontrol.RateLimiter
+
+	// Dry run outcomes of rules for their nodes, served by DryRunHandler
+	dryRunPlansMutex sync.Mutex
+	dryRunPlans      map[string]map[string]nodeDryRun // ruleName -> nodeName -> outcome
+
+	// Nodes each rule selected when it last evaluated them, whose release is
+	// handled by the node reconciler once they stop matching the rule
+	ruleNodesMutex sync.Mutex
+	ruleNodes      map[string]sets.Set[string] // ruleName -> nodeNames
+
+	// Parsed schedules of rules, so that they are not parsed for every node
+	schedulesMutex sync.Mutex
+	schedules      map[string]parsedSchedule // ruleName -> schedule
+
 	// Cache for efficient rule lookup
 	ruleCacheMutex sync.RWMutex
 	ruleCache      map[string]*readinessv1alpha1.NodeReadinessRule // ruleName -> rule
@@ -75,6 +108,7 @@ func NewRuleReadinessController(mgr ctrl.Manager, clientset kubernetes.Interface
 		Client:                 mgr.GetClient(),
 		Scheme:                 mgr.GetScheme(),
 		clientset:              clientset,
+		apiReader:              mgr.GetAPIReader(),
 		EventRecorder:          mgr.GetEventRecorder("node-readiness-controller"),
 		EnableNodeStateMetrics: enableNodeStateMetrics,
 		ruleCache:              make(map[string]*readinessv1alpha1.NodeReadinessRule),
@@ -91,11 +125,13 @@ func (r *RuleReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager)
 		Complete(r)
 }
 
-// +kubebuilder:rbac:groups=readiness.node.x-k8s.io,resources=nodereadinessrules,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=readiness.node.x-k8s.io,resources=nodereadinessrules,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=readiness.node.x-k8s.io,resources=nodereadinessrules/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=readiness.node.x-k8s.io,resources=nodereadinessrules/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=list
+// +kubebuilder:rbac:groups=core,resources=pods/eviction,verbs=create
 
 func (r *RuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -138,6 +174,44 @@ func (r *RuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	// Update rule cache (after cleanup)
 	r.Controller.updateRuleCache(ctx, rule)
 
+	// Load the per-node detail of the rule's status, migrating the entries
+	// still recorded inline in the rule.
+	if r.Controller.NodeStatusObjects {
+		unmigrated, err := r.Controller.loadRuleNodeStatuses(ctx, rule)
+		if err == nil {
+			err = r.Controller.migrateInlineNodeStatuses(ctx, rule, unmigrated, nodeList)
+		}
+		if err != nil {
+			log.Error(err, "Failed to load node statuses", "rule", rule.Name)
+			return ctrl.Result{RequeueAfter: time.Minute}, err
+		}
+	}
+
+	// Release the nodes the rule no longer selects, e.g. after its nodeSelector was edited.
+	if err := r.Controller.syncRuleScope(ctx, rule, nodeList); err != nil {
+		log.Error(err, "Failed to release nodes out of rule scope", "rule", rule.Name)
+		return ctrl.Result{RequeueAfter: time.Minute}, err
+	}
+
+	// Track how long the rule's current spec has been in dry run, before the
+	// schedule marks it as observed.
+	trackDryRunStart(rule, time.Now())
+
+	// A scheduled rule is deleted once expired and suspended outside its activation windows.
+	active, requeueAfter, err := r.reconcileSchedule(ctx, rule)
+	if err != nil {
+		log.Error(err, "Failed to reconcile schedule", "rule", rule.Name)
+		return ctrl.Result{RequeueAfter: time.Minute}, err
+	}
+	if !active {
+		rule.Status.ObservedGeneration = rule.Generation
+		if err := r.Controller.updateRuleStatus(ctx, rule); err != nil {
+			log.Error(err, "Failed to update rule status", "rule", rule.Name)
+			return ctrl.Result{RequeueAfter: time.Minute}, err
+		}
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
 	// Handle dry run
 	if rule.Spec.DryRun {
 		if err := r.Controller.processDryRun(ctx, rule, nodeList); err != nil {
@@ -147,12 +221,34 @@ func (r *RuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	} else {
 		// Clear previous dry run results
 		rule.Status.DryRunResults = readinessv1alpha1.DryRunResults{}
+		r.Controller.deleteDryRunPlan(rule.Name)
+
+		// A rule rolled out progressively is only enforced on a subset of the nodes.
+		rolloutRequeueAfter := r.Controller.progressRollout(ctx, rule, nodeList, time.Now())
+		if rolloutRequeueAfter > 0 && (requeueAfter == 0 || rolloutRequeueAfter < requeueAfter) {
+			requeueAfter = rolloutRequeueAfter
+		}
+		enforcedNodes, dryRunNodes := splitRolloutNodes(rule, nodeList)
+		if hasRollout(rule) {
+			if err := r.Controller.releaseRolloutNodes(ctx, rule, dryRunNodes); err != nil {
+				log.Error(err, "Failed to release nodes left out of the rollout", "rule", rule.Name)
+				return ctrl.Result{RequeueAfter: time.Minute}, err
+			}
+		}
 
 		// Process all applicable nodes for this rule
-		if err := r.Controller.processAllNodesForRule(ctx, rule, nodeList); err != nil {
+		if err := r.Controller.processAllNodesForRule(ctx, rule, enforcedNodes); err != nil {
 			log.Error(err, "Failed to process nodes for rule", "rule", rule.Name)
 			return ctrl.Result{RequeueAfter: time.Minute}, err
 		}
+
+		// The nodes the rule is not yet enforced on are accounted for in dry run.
+		if hasRollout(rule) {
+			if err := r.Controller.processDryRun(ctx, rule, dryRunNodes); err != nil {
+				log.Error(err, "Failed to process dry run", "rule", rule.Name)
+				return ctrl.Result{RequeueAfter: time.Minute}, err
+			}
+		}
 	}
 
 	// Update rule status
@@ -174,13 +270,14 @@ func (r *RuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		r.Controller.SyncNodeStateMetrics(ctx, rule)
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // reconcileDelete handles the rules deletion, It performs following actions
-// 1. Deletes the taints associated with the rule.
-// 2. Remove the rule from the cache.
-// 3. Remove the finalizer from the rule.
+// 1. Deletes, retains or hands over the taints associated with the rule, per its deletion policy.
+// 2. Removes the rule's bootstrap completion annotations from all nodes.
+// 3. Remove the rule from the cache.
+// 4. Remove the finalizer from the rule.
 func (r *RuleReconciler) reconcileDelete(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeList *corev1.NodeList) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -188,14 +285,45 @@ func (r *RuleReconciler) reconcileDelete(ctx context.Context, rule *readinessv1a
 	log.V(3).Info("Updating cache with deletion-marked rule before cleanup")
 	r.Controller.updateRuleCache(ctx, rule)
 
-	log.Info("Cleaning up taints for deleted rule", "rule", rule.Name)
-	if err := r.Controller.cleanupTaintsForRule(ctx, rule, nodeList); err != nil {
-		log.Error(err, "Failed to cleanup taints for rule", "rule", rule.Name)
+	switch rule.Spec.GetDeletionPolicy() {
+	case readinessv1alpha1.DeletionPolicyRetain:
+		log.Info("Retaining taints of deleted rule", "rule", rule.Name)
+		if err := r.Controller.retainRuleTaints(ctx, rule, nodeList); err != nil {
+			log.Error(err, "Failed to retain taints of rule", "rule", rule.Name)
+			return ctrl.Result{RequeueAfter: time.Minute}, err
+		}
+	case readinessv1alpha1.DeletionPolicyOrphanToRule:
+		handedOver, err := r.Controller.handOverTaintsToSuccessor(ctx, rule, nodeList)
+		if err != nil {
+			log.Error(err, "Failed to hand taints over to successor rule", "rule", rule.Name)
+			return ctrl.Result{RequeueAfter: time.Minute}, err
+		}
+		if !handedOver {
+			return ctrl.Result{RequeueAfter: successorRetryInterval}, nil
+		}
+	default:
+		log.Info("Cleaning up taints for deleted rule", "rule", rule.Name)
+		if err := r.Controller.cleanupTaintsForRule(ctx, rule, nodeList); err != nil {
+			log.Error(err, "Failed to cleanup taints for rule", "rule", rule.Name)
+			return ctrl.Result{RequeueAfter: time.Minute}, err
+		}
+	}
+
+	if err := r.Controller.removeBootstrapAnnotationsForRule(ctx, rule, nodeList); err != nil {
+		log.Error(err, "Failed to remove bootstrap completion annotations for rule", "rule", rule.Name)
+		return ctrl.Result{RequeueAfter: time.Minute}, err
+	}
+
+	r.Controller.deleteDryRunPlan(rule.Name)
+
+	if err := r.Controller.removeRuleNodeStatuses(ctx, rule.Name); err != nil {
+		log.Error(err, "Failed to remove node statuses of rule", "rule", rule.Name)
 		return ctrl.Result{RequeueAfter: time.Minute}, err
 	}
 
 	log.V(3).Info("Removing the rule from cache")
 	r.Controller.removeRuleFromCache(ctx, rule.Name)
+	r.Controller.removeEvictionLimiter(rule.Name)
 
 	log.V(3).Info("Removing the finalizer from the rule")
 	patch := client.MergeFrom(rule.DeepCopy())
@@ -213,11 +341,13 @@ func (r *RuleReconciler) reconcileDelete(ctx context.Context, rule *readinessv1a
 	metrics.BootstrapCompleted.DeleteLabelValues(rule.Name)
 	metrics.BootstrapDuration.DeleteLabelValues(rule.Name)
 	metrics.EvaluationDuration.DeleteLabelValues(rule.Name)
+	metrics.PodsEvicted.DeleteLabelValues(rule.Name)
 
 	// For multi-label metrics, use DeletePartialMatch to wipe all combinations
 	metrics.NodesByState.DeletePartialMatch(ruleLabel)
 	metrics.Failures.DeletePartialMatch(ruleLabel)
 	metrics.ConditionEvaluationFailures.DeletePartialMatch(ruleLabel)
+	metrics.BootstrapRearmed.DeletePartialMatch(ruleLabel)
 	metrics.TaintOperations.DeletePartialMatch(ruleLabel)
 	metrics.ReconciliationLatency.DeletePartialMatch(ruleLabel)
 
@@ -228,6 +358,11 @@ func (r *RuleReconciler) reconcileDelete(ctx context.Context, rule *readinessv1a
 func (r *RuleReadinessController) cleanupDeletedNodes(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeList *corev1.NodeList) error {
 	log := ctrl.LoggerFrom(ctx)
 
+	// NodeReadinessStatus objects are owned by their nodes and deleted with them.
+	if r.NodeStatusObjects {
+		return nil
+	}
+
 	existingNodes := make(map[string]bool, len(nodeList.Items))
 	for _, node := range nodeList.Items {
 		existingNodes[node.Name] = true
@@ -285,10 +420,14 @@ func (r *RuleReadinessController) processAllNodesForRule(ctx context.Context, ru
 	log.Info("Processing all nodes for rule", "rule", rule.Name, "totalNodes", len(nodeList.Items))
 
 	var appliedNodes []string
-	for _, node := range nodeList.Items {
-		if r.ruleAppliesTo(ctx, rule, &node) {
+	var storeErrs []error
+	// The nodes are evaluated in place, so that they are counted with their latest taints.
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if r.ruleAppliesTo(ctx, rule, node) {
 			log.Info("Processing node for rule", "rule", rule.Name, "node", node.Name)
-			if err := r.evaluateRuleForNode(ctx, rule, &node); err != nil {
+			previous := ruleEvaluationFor(rule, node.Name)
+			if err := r.evaluateRuleForNode(ctx, rule, node); err != nil {
 				log.Error(err, "Failed to evaluate node for rule", "rule", rule.Name, "node", node.Name)
 				r.recordNodeFailure(rule, node.Name, "EvaluationError", err.Error())
 				metrics.Failures.WithLabelValues(rule.Name, string(metrics.FailureReasonEvaluationError)).Inc()
@@ -302,19 +441,28 @@ func (r *RuleReadinessController) processAllNodesForRule(ctx context.Context, ru
 				}
 				rule.Status.FailedNodes = updatedFailedNodes
 			}
+			r.recordInlineNodeTransitions(rule, node.Name, previous)
+
+			if r.NodeStatusObjects {
+				if err := r.storeNodeStatus(ctx, rule, node); err != nil {
+					log.Error(err, "Failed to store node status", "rule", rule.Name, "node", node.Name)
+					storeErrs = append(storeErrs, fmt.Errorf("node %s: %w", node.Name, err))
+				}
+			}
 		}
 	}
 
 	// Update status
 	rule.Status.ObservedGeneration = rule.Generation
 	rule.Status.AppliedNodes = appliedNodes
+	setRuleNodeCounts(rule, r.countRuleNodes(ctx, rule, nodeList))
 
 	if !rule.Spec.DryRun {
 		rule.Status.DryRunResults = readinessv1alpha1.DryRunResults{}
 	}
 
 	log.Info("Completed processing nodes for rule", "rule", rule.Name, "processedCount", len(appliedNodes))
-	return nil
+	return errors.Join(storeErrs...)
 }
 
 // evaluateRuleForNode evaluates a single rule against a single node.
@@ -323,6 +471,19 @@ func (r *RuleReadinessController) evaluateRuleForNode(ctx context.Context, rule
 	defer timer.ObserveDuration()
 	log := ctrl.LoggerFrom(ctx)
 
+	// Adopt the bootstrap completion a previous incarnation of the rule recorded,
+	// before the node could be tainted again.
+	if err := r.adoptBootstrapCompletion(ctx, node, rule); err != nil {
+		return err
+	}
+
+	// Nodes that predate the rule's node scope complete bootstrap untouched.
+	if rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly && !nodeInNodeScope(rule, node) {
+		log.V(1).Info("Node is outside the rule's node scope, marking bootstrap completed", "node", node.Name, "rule", rule.Name)
+		r.markBootstrapCompleted(ctx, node.Name, rule)
+		return nil
+	}
+
 	// Evaluate all conditions, accumulating the policy result alongside per-condition results.
 	conditionResults := make([]readinessv1alpha1.ConditionEvaluationResult, 0, len(rule.Spec.Conditions))
 	conditionPolicy := rule.Spec.GetConditionPolicy()
@@ -369,12 +530,58 @@ func (r *RuleReadinessController) evaluateRuleForNode(ctx context.Context, rule
 	if conditionPolicy == readinessv1alpha1.ConditionPolicyAnyOf {
 		shouldRemoveTaint = anySatisfied
 	}
-	currentlyHasTaint := r.hasTaintBySpec(node, rule.Spec.Taint)
+	currentlyHasTaint := r.hasRuleTaint(node, rule)
+	conditionsSatisfied := shouldRemoveTaint
 
 	log.Info("Evaluation result", "node", node.Name, "rule", rule.Name,
 		"conditionPolicy", rule.Spec.GetConditionPolicy(), "conditionsSatisfied", shouldRemoveTaint, "hasTaint", currentlyHasTaint)
 
-	isFirstEvaluation := r.getPreviousNodeEvaluation(rule, node.Name) == nil
+	previousEvaluation := r.getPreviousNodeEvaluation(rule, node.Name)
+	isFirstEvaluation := previousEvaluation == nil
+
+	// A quarantined node keeps its taint until its conditions have been stable for the cooldown.
+	var flap readinessv1alpha1.FlapState
+	if flapDetectionEnabled(rule) {
+		flap = r.trackFlapping(ctx, node, rule, previousEvaluation, shouldRemoveTaint)
+		if isQuarantined(flap) && shouldRemoveTaint {
+			log.Info("Node is quarantined for flapping, holding taint", "node", node.Name, "rule", rule.Name)
+			shouldRemoveTaint = false
+		}
+	}
+
+	// Operator overrides take precedence over the condition evaluation.
+	override := r.resolveOverrideForNode(ctx, node, rule, previousEvaluation)
+	switch override.Action {
+	case readinessv1alpha1.OverrideActionForceHold:
+		shouldRemoveTaint = false
+	case readinessv1alpha1.OverrideActionForceRelease:
+		shouldRemoveTaint = true
+	case readinessv1alpha1.OverrideActionExempt:
+		log.Info("Node is exempt from rule, leaving taints untouched", "node", node.Name, "rule", rule.Name,
+			"annotation", override.Annotation)
+		r.updateNodeEvaluationStatus(rule, node.Name, conditionResults, taintStatusOf(currentlyHasTaint), override, flap,
+			readinessv1alpha1.NodeDrainStatus{})
+		return nil
+	}
+	overridden := override.Action != ""
+
+	// A Node that already carried the taint is handled according to the rule's taint adoption policy.
+	adoption, err := r.applyTaintAdoptionPolicy(ctx, node, rule, previousEvaluation, shouldRemoveTaint)
+	if err != nil {
+		metrics.Failures.WithLabelValues(rule.Name, string(metrics.FailureReasonAddTaintError)).Inc()
+		return fmt.Errorf("failed to replace taint: %w", err)
+	}
+	if adoption == readinessv1alpha1.TaintAdoptionRefused {
+		log.Info("Node carries a pre-existing taint the rule refused to adopt, leaving taints untouched",
+			"node", node.Name, "rule", rule.Name)
+		r.updateNodeEvaluationStatus(rule, node.Name, conditionResults, taintStatusOf(true), override, flap,
+			readinessv1alpha1.NodeDrainStatus{})
+		r.getPreviousNodeEvaluation(rule, node.Name).TaintAdoption = adoption
+		return nil
+	}
+	adopting := adoption == readinessv1alpha1.TaintAdoptionAdopted &&
+		(isFirstEvaluation || previousEvaluation.TaintAdoption != readinessv1alpha1.TaintAdoptionAdopted)
+	currentlyHasTaint = r.hasRuleTaint(node, rule)
 
 	// Calculate the latest transition time globally so all metrics can share it.
 	// We intentionally isolate the most recent transition time among all required conditions.
@@ -403,16 +610,19 @@ func (r *RuleReadinessController) evaluateRuleForNode(ctx context.Context, rule
 		}
 	}
 
-	var err error
+	var drain readinessv1alpha1.NodeDrainStatus
+	var added bool
+	var escalationStarted metav1.Time
 
 	switch {
 	case shouldRemoveTaint && currentlyHasTaint:
 		log.Info("Removing taint", "node", node.Name, "rule", rule.Name, "taint", rule.Spec.Taint.Key)
 
-		if rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly {
+		// A forced release does not complete bootstrap, so the rule resumes once the override is removed.
+		if rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly && !overridden {
 			err = r.removeTaintAndCompleteBootstrap(ctx, node, rule)
 		} else {
-			err = r.removeTaintBySpec(ctx, node, rule.Spec.Taint, rule.Name)
+			err = r.removeRuleTaint(ctx, node, rule)
 		}
 		if err != nil {
 			metrics.Failures.WithLabelValues(rule.Name, string(metrics.FailureReasonRemoveTaintError)).Inc()
@@ -423,7 +633,7 @@ func (r *RuleReadinessController) evaluateRuleForNode(ctx context.Context, rule
 		metrics.TaintOperations.WithLabelValues(rule.Name, string(metrics.TaintOperationRemove)).Inc()
 		recordLatency(string(metrics.ReconciliationOperationRemoveTaint))
 
-		if rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly {
+		if rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly && !overridden {
 			// Only record the bootstrap duration if the node was created AFTER the rule.
 			// This prevents legacy nodes from poisoning the histogram with massive outliers.
 			if !node.CreationTimestamp.Time.Before(rule.CreationTimestamp.Time) && !latestTransition.IsZero() {
@@ -443,7 +653,6 @@ func (r *RuleReadinessController) evaluateRuleForNode(ctx context.Context, rule
 	case !shouldRemoveTaint && !currentlyHasTaint:
 		log.Info("Adding taint", "node", node.Name, "rule", rule.Name, "taint", rule.Spec.Taint.Key)
 
-		var added bool
 		if added, err = r.addTaintBySpec(ctx, node, rule); err != nil {
 			metrics.Failures.WithLabelValues(rule.Name, string(metrics.FailureReasonAddTaintError)).Inc()
 			return fmt.Errorf("failed to add taint: %w", err)
@@ -456,42 +665,82 @@ func (r *RuleReadinessController) evaluateRuleForNode(ctx context.Context, rule
 		}
 
 	case !shouldRemoveTaint && currentlyHasTaint:
-		if isFirstEvaluation {
+		if adopting {
 			log.Info("Adopting pre-existing taint", "node", node.Name, "rule", rule.Name, "taint", rule.Spec.Taint.Key)
 
 			message := fmt.Sprintf("Taint '%s:%s' is now managed by rule '%s'", rule.Spec.Taint.Key, rule.Spec.Taint.Effect, rule.Name)
 			r.EventRecorder.Eventf(node, nil, corev1.EventTypeNormal, "TaintAdopted", "AdoptTaint", "%s", message)
 		}
 
+		// Escalation and drain are both timed from the start of the escalation ladder, which is the
+		// taint's TimeAdded, stamped by escalateTaint if missing, until it is escalated to NoExecute.
+		if len(rule.Spec.TaintEscalation) > 0 || drainEnabled(rule) {
+			var escalated bool
+			if escalated, escalationStarted, err = r.escalateTaint(ctx, node, rule); err != nil {
+				metrics.Failures.WithLabelValues(rule.Name, string(metrics.FailureReasonEscalateTaintError)).Inc()
+				return fmt.Errorf("failed to escalate taint: %w", err)
+			}
+			if escalated {
+				metrics.TaintOperations.WithLabelValues(rule.Name, string(metrics.TaintOperationEscalate)).Inc()
+			}
+		}
+
+		// Only drain nodes that are actually failing, not ones held by a quarantine or an override.
+		if drainEnabled(rule) && !conditionsSatisfied && !overridden {
+			if drain, err = r.drainNode(ctx, node, rule, previousEvaluation); err != nil {
+				metrics.Failures.WithLabelValues(rule.Name, string(metrics.FailureReasonDrainError)).Inc()
+				r.updateNodeEvaluationStatus(rule, node.Name, conditionResults, taintStatusOf(true), override, flap, drain)
+				return fmt.Errorf("failed to drain node: %w", err)
+			}
+		}
+
 	default:
 		log.Info("No taint action needed", "node", node.Name, "rule", rule.Name,
 			"shouldRemove", shouldRemoveTaint, "hasTaint", currentlyHasTaint)
 		// Mark bootstrap completed in bootstrap-only mode when conditions satisfied even if taint is already absent.
-		if rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly {
+		if rule.Spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly && !overridden {
 			r.markBootstrapCompleted(ctx, node.Name, rule)
 		}
 	}
 
 	// Determine observed taint status after any actions
-	var taintStatus readinessv1alpha1.TaintStatus
-	if r.hasTaintBySpec(node, rule.Spec.Taint) {
-		taintStatus = readinessv1alpha1.TaintStatusPresent
-	} else {
-		taintStatus = readinessv1alpha1.TaintStatusAbsent
-	}
+	taintStatus := taintStatusOf(r.hasRuleTaint(node, rule))
 
 	// Update evaluation status
-	r.updateNodeEvaluationStatus(rule, node.Name, conditionResults, taintStatus)
+	r.updateNodeEvaluationStatus(rule, node.Name, conditionResults, taintStatus, override, flap, drain)
+	evaluation := r.getPreviousNodeEvaluation(rule, node.Name)
+	evaluation.TaintAdoption = adoption
+	if added {
+		evaluation.TaintAddedTime = metav1.Now()
+	}
+	switch {
+	case added || taintStatus == readinessv1alpha1.TaintStatusAbsent:
+		// A new taint starts a new escalation ladder from its TimeAdded.
+		evaluation.EscalationStartTime = metav1.Time{}
+	case !escalationStarted.IsZero():
+		evaluation.EscalationStartTime = escalationStarted
+	}
 
 	return nil
 }
 
+// taintStatusOf converts the presence of a taint into a TaintStatus.
+func taintStatusOf(hasTaint bool) readinessv1alpha1.TaintStatus {
+	if hasTaint {
+		return readinessv1alpha1.TaintStatusPresent
+	}
+	return readinessv1alpha1.TaintStatusAbsent
+}
+
 // updateNodeEvaluationStatus updates the evaluation status for a specific node.
 func (r *RuleReadinessController) updateNodeEvaluationStatus(
 	rule *readinessv1alpha1.NodeReadinessRule,
 	nodeName string,
 	conditionResults []readinessv1alpha1.ConditionEvaluationResult,
 	taintStatus readinessv1alpha1.TaintStatus,
+	override readinessv1alpha1.NodeOverride,
+	flap readinessv1alpha1.FlapState,
+	drain readinessv1alpha1.NodeDrainStatus,
 ) {
 	// Find existing evaluation or create new
 	var nodeEval *readinessv1alpha1.NodeEvaluation
@@ -513,6 +762,9 @@ func (r *RuleReadinessController) updateNodeEvaluationStatus(
 	nodeEval.ConditionResults = conditionResults
 	nodeEval.TaintStatus = taintStatus
 	nodeEval.LastEvaluationTime = metav1.Now()
+	nodeEval.Override = override
+	nodeEval.Flap = flap
+	nodeEval.Drain = drain
 }
 
 // getApplicableRulesForNode returns all rules applicable to a node.
@@ -565,7 +817,7 @@ func (r *RuleReadinessController) ListRuleNodeStates(ctx context.Context) (map[s
 			if !selector.Matches(labels.Set(node.Labels)) {
 				continue
 			}
-			if r.hasTaintBySpec(node, rule.Spec.Taint) {
+			if r.hasRuleTaint(node, rule) {
 				rc.Held++
 			} else {
 				rc.Released++
@@ -612,6 +864,8 @@ func (r *RuleReadinessController) removeRuleFromCache(ctx context.Context, ruleN
 	defer r.ruleCacheMutex.Unlock()
 
 	delete(r.ruleCache, ruleName)
+	r.deleteRuleNodes(ruleName)
+	r.deleteRuleSchedule(ruleName)
 	metrics.RulesTotal.Set(float64(len(r.ruleCache)))
 	log.Info("Removed rule from cache", "rule", ruleName, "totalRules", len(r.ruleCache))
 }
@@ -631,13 +885,33 @@ func (r *RuleReadinessController) updateRuleStatus(ctx context.Context, rule *re
 			return err
 		}
 
-		patch := client.MergeFrom(latestRule.DeepCopy())
+		// The dry run results are computed after the rule is read and written
+		// with an optimistic lock, as patchDryRunResults does, so that the
+		// latest plan wins over results computed earlier.
+		patch := client.MergeFromWithOptions(latestRule.DeepCopy(), client.MergeFromWithOptimisticLock{})
 
-		latestRule.Status.NodeEvaluations = rule.Status.NodeEvaluations
-		latestRule.Status.AppliedNodes = rule.Status.AppliedNodes
-		latestRule.Status.FailedNodes = rule.Status.FailedNodes
+		if r.NodeStatusObjects {
+			// The per-node detail is recorded in NodeReadinessStatus objects;
+			// this also drops the entries migrated from the rule.
+			latestRule.Status.NodeEvaluations = nil
+			latestRule.Status.AppliedNodes = nil
+			latestRule.Status.FailedNodes = nil
+		} else {
+			latestRule.Status.NodeEvaluations = rule.Status.NodeEvaluations
+			latestRule.Status.AppliedNodes = rule.Status.AppliedNodes
+			latestRule.Status.FailedNodes = rule.Status.FailedNodes
+		}
 		latestRule.Status.ObservedGeneration = rule.Status.ObservedGeneration
 		latestRule.Status.DryRunResults = rule.Status.DryRunResults
+		if results, ok := r.dryRunResultsOf(rule.Name); ok {
+			latestRule.Status.DryRunResults = results
+		}
+		latestRule.Status.DryRunStartTime = rule.Status.DryRunStartTime
+		latestRule.Status.ScheduleState = rule.Status.ScheduleState
+		latestRule.Status.NextTransitionTime = rule.Status.NextTransitionTime
+		latestRule.Status.Rollout = rule.Status.Rollout
+		latestRule.Status.NodeCounts = rule.Status.NodeCounts
+		setRuleConditions(latestRule)
 
 		if err := r.Status().Patch(ctx, latestRule, patch); err != nil {
 			log.V(1).Info("Status patch conflict, will retry",
@@ -651,85 +925,23 @@ func (r *RuleReadinessController) updateRuleStatus(ctx context.Context, rule *re
 	})
 }
 
-// processDryRun processes dry run for a rule.
-//
-//nolint:unparam // Keep error return for future extensibility and API stability.
-func (r *RuleReadinessController) processDryRun(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeList *corev1.NodeList) error {
-	var affectedNodes, taintsToAdd, taintsToRemove, riskyOps int32
-	var summaryParts []string
-
-	for _, node := range nodeList.Items {
-		if !r.ruleAppliesTo(ctx, rule, &node) {
+// retainRuleTaints records the taints a rule deleted with the Retain deletion
+// policy leaves on the nodes it selects, so that they are not swept as orphans.
+func (r *RuleReadinessController) retainRuleTaints(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeList *corev1.NodeList) error {
+	if rule.Spec.DryRun {
+		return nil
+	}
+	var errs []error
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if !r.ruleAppliesTo(ctx, rule, node) || !r.hasRuleTaint(node, rule) {
 			continue
 		}
-
-		affectedNodes++
-
-		// Simulate rule evaluation using the rule's conditionPolicy
-		conditionPolicy := rule.Spec.GetConditionPolicy()
-		missingConditions := 0
-		allSatisfied := true
-		anySatisfied := false
-
-		for _, condReq := range rule.Spec.Conditions {
-			currentStatus, conditionFound := r.getConditionStatus(
-				&node,
-				condReq.Type,
-				condReq.GetDefaultStatus(),
-			)
-			if !conditionFound {
-				missingConditions++
-			}
-			if currentStatus != condReq.RequiredStatus {
-				allSatisfied = false
-			} else {
-				anySatisfied = true
-			}
-		}
-
-		shouldRemoveTaint := allSatisfied
-		if conditionPolicy == readinessv1alpha1.ConditionPolicyAnyOf {
-			shouldRemoveTaint = anySatisfied
-		}
-		currentlyHasTaint := r.hasTaintBySpec(&node, rule.Spec.Taint)
-
-		if shouldRemoveTaint && currentlyHasTaint {
-			taintsToRemove++
-		} else if !shouldRemoveTaint && !currentlyHasTaint {
-			taintsToAdd++
-		}
-
-		if missingConditions > 0 {
-			riskyOps++
+		if err := r.retainRuleTaint(ctx, node, rule); err != nil {
+			errs = append(errs, fmt.Errorf("node %s: %w", node.Name, err))
 		}
 	}
-
-	// Build summary
-	if taintsToAdd > 0 {
-		summaryParts = append(summaryParts, fmt.Sprintf("would add %d taints", taintsToAdd))
-	}
-	if taintsToRemove > 0 {
-		summaryParts = append(summaryParts, fmt.Sprintf("would remove %d taints", taintsToRemove))
-	}
-	if riskyOps > 0 {
-		summaryParts = append(summaryParts, fmt.Sprintf("%d nodes have missing conditions", riskyOps))
-	}
-
-	summary := "No changes needed"
-	if len(summaryParts) > 0 {
-		summary = strings.Join(summaryParts, ", ")
-	}
-
-	// Update rule status with dry run results
-	rule.Status.ObservedGeneration = rule.Generation
-	rule.Status.DryRunResults = readinessv1alpha1.DryRunResults{
-		AffectedNodes:   &affectedNodes,
-		TaintsToAdd:     &taintsToAdd,
-		TaintsToRemove:  &taintsToRemove,
-		RiskyOperations: &riskyOps,
-		Summary:         summary,
-	}
-	return nil
+	return errors.Join(errs...)
 }
 
 // cleanupTaintsForRule removes taints managed by this rule from all applicable nodes.
@@ -743,13 +955,13 @@ func (r *RuleReadinessController) cleanupTaintsForRule(ctx context.Context, rule
 		}
 
 		// Check if node has the taint managed by this rule
-		if r.hasTaintBySpec(&node, rule.Spec.Taint) {
+		if r.hasRuleTaint(&node, rule) {
 			log.Info("Removing taint from node during rule cleanup",
 				"node", node.Name,
 				"rule", rule.Name,
 				"taint", rule.Spec.Taint.Key)
 
-			if err := r.removeTaintBySpec(ctx, &node, rule.Spec.Taint, rule.Name); err != nil {
+			if err := r.removeRuleTaint(ctx, &node, rule); err != nil {
 				errors = append(errors, fmt.Sprintf("node %s: %v", node.Name, err))
 			}
 		}
@@ -1157,7 +1157,7 @@ var _ = Describe("NodeReadinessRule Controller", func() {
 			}
 
 			// Initially not completed
-			completed := readinessController.isBootstrapCompleted(ctx, nodeName, rule.Name, rule.GetUID())
+			completed := readinessController.isBootstrapCompleted(ctx, nodeName, rule)
 			Expect(completed).To(BeFalse())
 
 			// Create a node for testing
@@ -1174,21 +1174,25 @@ var _ = Describe("NodeReadinessRule Controller", func() {
 
 			// Should now be completed
 			Eventually(func() bool {
-				return readinessController.isBootstrapCompleted(ctx, nodeName, rule.Name, rule.GetUID())
+				return readinessController.isBootstrapCompleted(ctx, nodeName, rule)
 			}).Should(BeTrue())
 		})
 
 		It("should return false when context is cancelled", func() {
 			nodeName := "bootstrap-ctx-test-node"
-			ruleName := "bootstrap-ctx-test-rule"
-			ruleUID := types.UID("22222222-2222-2222-2222-222222222222")
+			rule := &nodereadinessiov1alpha1.NodeReadinessRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "bootstrap-ctx-test-rule",
+					UID:  types.UID("22222222-2222-2222-2222-222222222222"),
+				},
+			}
 
 			// Create a node with the UID-based bootstrap annotation already set
 			node := &corev1.Node{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: nodeName,
 					Annotations: map[string]string{
-						bootstrapAnnotationKey(ruleUID): `{"rule-name":"bootstrap-ctx-test-rule"}`,
+						bootstrapAnnotationKey(rule.UID): `{"rule-name":"bootstrap-ctx-test-rule"}`,
 					},
 				},
 			}
@@ -1196,12 +1200,12 @@ var _ = Describe("NodeReadinessRule Controller", func() {
 			defer func() { _ = k8sClient.Delete(ctx, node) }()
 
 			// Verify it returns true with a valid context
-			Expect(readinessController.isBootstrapCompleted(ctx, nodeName, ruleName, ruleUID)).To(BeTrue())
+			Expect(readinessController.isBootstrapCompleted(ctx, nodeName, rule)).To(BeTrue())
 
 			// A cancelled context should cause the Get to fail, returning false
 			cancelledCtx, cancel := context.WithCancel(ctx)
 			cancel()
-			Expect(readinessController.isBootstrapCompleted(cancelledCtx, nodeName, ruleName, ruleUID)).To(BeFalse())
+			Expect(readinessController.isBootstrapCompleted(cancelledCtx, nodeName, rule)).To(BeFalse())
 		})
 
 		It("should set bootstrap annotation via patch in markBootstrapCompleted", func() {
@@ -2025,6 +2029,13 @@ var _ = Describe("NodeReadinessRule Controller", func() {
 			Expect(err.Error()).To(ContainSubstring("conditions is immutable"))
 		})
 
+		It("should allow setting defaultStatus to Unknown explicitly", func() {
+			updatedRule := &nodereadinessiov1alpha1.NodeReadinessRule{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "immutability-test-rule"}, updatedRule)).To(Succeed())
+			updatedRule.Spec.Conditions[0].DefaultStatus = corev1.ConditionUnknown
+			Expect(k8sClient.Update(ctx, updatedRule)).To(Succeed())
+		})
+
 		It("should reject attempts to change enforcementMode", func() {
 			updatedRule := &nodereadinessiov1alpha1.NodeReadinessRule{}
 			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "immutability-test-rule"}, updatedRule)).To(Succeed())
@@ -0,0 +1,309 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/metrics"
+)
+
+// readinessTaintPrefix is the key prefix of all taints managed by rules.
+const readinessTaintPrefix = "readiness.k8s.io/"
+
+// retainedTaintsAnnotationKey lists the readiness taints deliberately left on
+// a Node by a rule's KeepTaint node exit policy or Retain deletion policy, so
+// that the sweeper does not treat them as orphaned.
+//
+// Value format: comma-separated <key>:<effect>
+// e.g.          readiness.k8s.io/gpu-ready:NoSchedule
+const retainedTaintsAnnotationKey = "readiness.k8s.io/retained-taints"
+
+// orphanedTaint identifies a readiness taint on a Node.
+type orphanedTaint struct {
+	nodeName string
+	key      string
+	effect   corev1.TaintEffect
+}
+
+// OrphanedTaintSweeper periodically looks for readiness taints that no live
+// rule manages, e.g. because a rule was deleted while the controller was not
+// running or its finalizer was removed by hand. Orphaned taints are reported
+// through a metric and events, and optionally removed once they have been
+// orphaned for a grace period.
+type OrphanedTaintSweeper struct {
+	client.Client
+	Controller *RuleReadinessController
+
+	// Interval is how often nodes are swept.
+	Interval time.Duration
+	// RemovalGracePeriod is how long a taint must have been found orphaned
+	// before it is removed. Zero disables removal.
+	RemovalGracePeriod time.Duration
+
+	// orphanedSince records when each orphaned taint was first found.
+	orphanedSince map[orphanedTaint]time.Time
+}
+
+// SetupWithManager adds the sweeper to the Manager.
+func (s *OrphanedTaintSweeper) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(s)
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, so that only
+// the leader sweeps.
+func (s *OrphanedTaintSweeper) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable.
+func (s *OrphanedTaintSweeper) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("orphaned-taint-sweeper")
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := s.sweep(ctx); err != nil {
+			log.Error(err, "Failed to sweep orphaned taints")
+		}
+	}, s.Interval)
+	return nil
+}
+
+// taintID identifies a taint in the retained taints annotation.
+func taintID(key string, effect corev1.TaintEffect) string {
+	return key + ":" + string(effect)
+}
+
+// retainedTaints returns the taints recorded as retained on the node.
+func retainedTaints(node *corev1.Node) sets.Set[string] {
+	value := node.Annotations[retainedTaintsAnnotationKey]
+	if value == "" {
+		return sets.New[string]()
+	}
+	return sets.New(strings.Split(value, ",")...)
+}
+
+// setRetainedTaints records the retained taints on the node, removing the
+// annotation when there are none.
+func setRetainedTaints(node *corev1.Node, retained sets.Set[string]) {
+	if retained.Len() == 0 {
+		delete(node.Annotations, retainedTaintsAnnotationKey)
+		return
+	}
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	node.Annotations[retainedTaintsAnnotationKey] = strings.Join(sets.List(retained), ",")
+}
+
+// retainRuleTaint records the rule's taint on the node as deliberately left
+// in place, if the node carries it.
+func (r *RuleReadinessController) retainRuleTaint(ctx context.Context, node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestNode := &corev1.Node{}
+		if err := r.Get(ctx, client.ObjectKey{Name: node.Name}, latestNode); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		taint := findRuleTaint(latestNode, rule)
+		if taint == nil {
+			return nil
+		}
+		retained := retainedTaints(latestNode)
+		id := taintID(taint.Key, taint.Effect)
+		if retained.Has(id) {
+			return nil
+		}
+
+		stored := latestNode.DeepCopy()
+		setRetainedTaints(latestNode, retained.Insert(id))
+		return r.Patch(ctx, latestNode, client.MergeFromWithOptions(stored, client.MergeFromWithOptimisticLock{}))
+	})
+}
+
+// isReadinessTaintManaged reports whether any of the rules manages the taint
+// on the node, i.e. has the same key and effect and selects the node.
+func (s *OrphanedTaintSweeper) isReadinessTaintManaged(ctx context.Context, node *corev1.Node, taint corev1.Taint, rules []readinessv1alpha1.NodeReadinessRule) bool {
+	for i := range rules {
+		rule := &rules[i]
+		if rule.Spec.Taint.Key == taint.Key &&
+			slices.Contains(rule.Spec.GetTaintEffects(), taint.Effect) &&
+			s.Controller.ruleAppliesTo(ctx, rule, node) {
+			return true
+		}
+	}
+	return false
+}
+
+// sweep finds the orphaned readiness taints on all nodes, reports them and
+// removes those that have been orphaned for longer than the grace period.
+func (s *OrphanedTaintSweeper) sweep(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Nodes are listed before rules, so that a taint added by a rule created
+	// in between is not mistaken for an orphan.
+	nodeList := &corev1.NodeList{}
+	if err := s.List(ctx, nodeList); err != nil {
+		return err
+	}
+	// Rules being deleted still count as live, as they clean up their own taints.
+	ruleList := &readinessv1alpha1.NodeReadinessRuleList{}
+	if err := s.List(ctx, ruleList); err != nil {
+		return err
+	}
+
+	if s.orphanedSince == nil {
+		s.orphanedSince = make(map[orphanedTaint]time.Time)
+	}
+
+	now := time.Now()
+	found := make(map[orphanedTaint]bool)
+	counts := make(map[[2]string]int)
+	var errs []error
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		retained := retainedTaints(node)
+		if err := s.pruneRetainedTaints(ctx, node, retained); err != nil {
+			errs = append(errs, fmt.Errorf("failed to prune retained taints of node %s: %w", node.Name, err))
+		}
+		for _, taint := range node.Spec.Taints {
+			if !strings.HasPrefix(taint.Key, readinessTaintPrefix) ||
+				retained.Has(taintID(taint.Key, taint.Effect)) ||
+				s.isReadinessTaintManaged(ctx, node, taint, ruleList.Items) {
+				continue
+			}
+
+			orphan := orphanedTaint{nodeName: node.Name, key: taint.Key, effect: taint.Effect}
+			since, seen := s.orphanedSince[orphan]
+			if !seen {
+				since = now
+				s.orphanedSince[orphan] = since
+				log.Info("Found orphaned taint", "node", node.Name, "taint", taint.Key, "effect", taint.Effect)
+				s.Controller.EventRecorder.Eventf(node, nil, corev1.EventTypeWarning, "OrphanedTaint", "ReportOrphanedTaint",
+					"Taint '%s:%s' is not managed by any NodeReadinessRule", taint.Key, taint.Effect)
+			}
+
+			if s.RemovalGracePeriod > 0 && now.Sub(since) >= s.RemovalGracePeriod {
+				if err := s.removeOrphanedTaint(ctx, node, taint); err != nil {
+					errs = append(errs, fmt.Errorf("failed to remove orphaned taint %s from node %s: %w", taint.Key, node.Name, err))
+				} else {
+					delete(s.orphanedSince, orphan)
+					continue
+				}
+			}
+
+			found[orphan] = true
+			counts[[2]string{taint.Key, string(taint.Effect)}]++
+		}
+	}
+
+	// Forget taints that are no longer orphaned or no longer present.
+	for orphan := range s.orphanedSince {
+		if !found[orphan] {
+			delete(s.orphanedSince, orphan)
+		}
+	}
+
+	metrics.OrphanedTaints.Reset()
+	for labels, count := range counts {
+		metrics.OrphanedTaints.WithLabelValues(labels[0], labels[1]).Set(float64(count))
+	}
+
+	return errors.Join(errs...)
+}
+
+// pruneRetainedTaints drops the taints no longer on the node from its
+// retained taints annotation, so that a taint added again later is swept as
+// usual once orphaned.
+func (s *OrphanedTaintSweeper) pruneRetainedTaints(ctx context.Context, node *corev1.Node, retained sets.Set[string]) error {
+	present := sets.New[string]()
+	for _, taint := range node.Spec.Taints {
+		present.Insert(taintID(taint.Key, taint.Effect))
+	}
+	if retained.Difference(present).Len() == 0 {
+		return nil
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestNode := &corev1.Node{}
+		if err := s.Get(ctx, client.ObjectKey{Name: node.Name}, latestNode); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		present := sets.New[string]()
+		for _, taint := range latestNode.Spec.Taints {
+			present.Insert(taintID(taint.Key, taint.Effect))
+		}
+		latestRetained := retainedTaints(latestNode)
+		if latestRetained.Difference(present).Len() == 0 {
+			return nil
+		}
+
+		stored := latestNode.DeepCopy()
+		setRetainedTaints(latestNode, latestRetained.Intersection(present))
+		return s.Patch(ctx, latestNode, client.MergeFromWithOptions(stored, client.MergeFromWithOptimisticLock{}))
+	})
+}
+
+// removeOrphanedTaint removes the taint from the node.
+func (s *OrphanedTaintSweeper) removeOrphanedTaint(ctx context.Context, node *corev1.Node, taint corev1.Taint) error {
+	log := ctrl.LoggerFrom(ctx)
+	removed := false
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		removed = false
+
+		latestNode := &corev1.Node{}
+		if err := s.Get(ctx, client.ObjectKey{Name: node.Name}, latestNode); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		stored := latestNode.DeepCopy()
+		latestNode.Spec.Taints = slices.DeleteFunc(latestNode.Spec.Taints, func(t corev1.Taint) bool {
+			return t.Key == taint.Key && t.Effect == taint.Effect
+		})
+		if len(latestNode.Spec.Taints) == len(stored.Spec.Taints) {
+			return nil
+		}
+		if err := s.Patch(ctx, latestNode, client.MergeFromWithOptions(stored, client.MergeFromWithOptimisticLock{})); err != nil {
+			return err
+		}
+
+		removed = true
+		return nil
+	})
+	if err != nil || !removed {
+		return err
+	}
+
+	log.Info("Removed orphaned taint", "node", node.Name, "taint", taint.Key, "effect", taint.Effect)
+	metrics.OrphanedTaintsRemoved.WithLabelValues(taint.Key).Inc()
+	s.Controller.EventRecorder.Eventf(node, nil, corev1.EventTypeNormal, "OrphanedTaintRemoved", "RemoveOrphanedTaint",
+		"Taint '%s:%s' removed after not being managed by any NodeReadinessRule for %s", taint.Key, taint.Effect, s.RemovalGracePeriod)
+	return nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/metrics"
+)
+
+func newOrphanedTaintSweeper(t *testing.T, grace time.Duration, objects ...client.Object) (*OrphanedTaintSweeper, *events.FakeRecorder) {
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(objects...).Build()
+	recorder := events.NewFakeRecorder(10)
+	return &OrphanedTaintSweeper{
+		Client:             fc,
+		Controller:         &RuleReadinessController{Client: fc, EventRecorder: recorder},
+		RemovalGracePeriod: grace,
+	}, recorder
+}
+
+func TestOrphanedTaintSweeper_Report(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	managed := gpuNode("managed", true)
+	unselected := gpuNode("unselected", true)
+	unselected.Labels = map[string]string{"gpu": "false"}
+	otherEffect := gpuNode("other-effect", false)
+	otherEffect.Spec.Taints = []corev1.Taint{
+		{Key: "readiness.k8s.io/gpu-ready", Effect: corev1.TaintEffectNoExecute},
+		{Key: "example.com/unrelated", Effect: corev1.TaintEffectNoSchedule},
+	}
+	s, recorder := newOrphanedTaintSweeper(t, 0, gpuRule(), managed, unselected, otherEffect)
+
+	g.Expect(s.sweep(ctx)).To(Succeed())
+
+	g.Expect(s.orphanedSince).To(HaveLen(2))
+	g.Expect(s.orphanedSince).To(HaveKey(orphanedTaint{nodeName: "unselected", key: "readiness.k8s.io/gpu-ready", effect: corev1.TaintEffectNoSchedule}))
+	g.Expect(s.orphanedSince).To(HaveKey(orphanedTaint{nodeName: "other-effect", key: "readiness.k8s.io/gpu-ready", effect: corev1.TaintEffectNoExecute}))
+	g.Expect(testutil.ToFloat64(metrics.OrphanedTaints.WithLabelValues("readiness.k8s.io/gpu-ready", "NoSchedule"))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(metrics.OrphanedTaints.WithLabelValues("readiness.k8s.io/gpu-ready", "NoExecute"))).To(Equal(1.0))
+	g.Expect(recorder.Events).To(HaveLen(2))
+	g.Expect(<-recorder.Events).To(ContainSubstring("is not managed by any NodeReadinessRule"))
+	<-recorder.Events
+
+	// Orphans are reported once, and never removed without a grace period.
+	g.Expect(s.sweep(ctx)).To(Succeed())
+	g.Expect(recorder.Events).To(BeEmpty())
+	node := &corev1.Node{}
+	g.Expect(s.Get(ctx, client.ObjectKey{Name: "unselected"}, node)).To(Succeed())
+	g.Expect(node.Spec.Taints).To(HaveLen(1))
+
+	// A taint a rule manages again is forgotten.
+	rule := gpuRule()
+	rule.Name = "gpu-ready-fallback"
+	rule.Spec.NodeSelector.MatchLabels = map[string]string{"gpu": "false"}
+	g.Expect(s.Create(ctx, rule)).To(Succeed())
+	g.Expect(s.sweep(ctx)).To(Succeed())
+	g.Expect(s.orphanedSince).To(HaveLen(1))
+	g.Expect(testutil.ToFloat64(metrics.OrphanedTaints.WithLabelValues("readiness.k8s.io/gpu-ready", "NoSchedule"))).To(BeZero())
+}
+
+func TestOrphanedTaintSweeper_Remove(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	node := gpuNode("orphaned", true)
+	node.Spec.Taints = append(node.Spec.Taints, corev1.Taint{Key: "example.com/unrelated", Effect: corev1.TaintEffectNoSchedule})
+	s, recorder := newOrphanedTaintSweeper(t, time.Hour, node)
+	orphan := orphanedTaint{nodeName: "orphaned", key: "readiness.k8s.io/gpu-ready", effect: corev1.TaintEffectNoSchedule}
+
+	// Taints are kept during the grace period.
+	g.Expect(s.sweep(ctx)).To(Succeed())
+	g.Expect(s.orphanedSince).To(HaveKey(orphan))
+	<-recorder.Events
+
+	s.orphanedSince[orphan] = time.Now().Add(-2 * time.Hour)
+	removedBefore := testutil.ToFloat64(metrics.OrphanedTaintsRemoved.WithLabelValues("readiness.k8s.io/gpu-ready"))
+	g.Expect(s.sweep(ctx)).To(Succeed())
+
+	updated := &corev1.Node{}
+	g.Expect(s.Get(ctx, client.ObjectKey{Name: "orphaned"}, updated)).To(Succeed())
+	g.Expect(updated.Spec.Taints).To(ConsistOf(corev1.Taint{Key: "example.com/unrelated", Effect: corev1.TaintEffectNoSchedule}))
+	g.Expect(s.orphanedSince).To(BeEmpty())
+	g.Expect(testutil.ToFloat64(metrics.OrphanedTaintsRemoved.WithLabelValues("readiness.k8s.io/gpu-ready"))).To(Equal(removedBefore + 1))
+	g.Expect(<-recorder.Events).To(ContainSubstring("OrphanedTaintRemoved"))
+}
+
+func TestOrphanedTaintSweeper_RetainedTaints(t *testing.T) {
+	// sweepTwice sweeps once to find orphans and again past the grace period,
+	// and returns the node afterwards.
+	sweepTwice := func(g *WithT, s *OrphanedTaintSweeper, nodeName string) *corev1.Node {
+		ctx := context.Background()
+		g.Expect(s.sweep(ctx)).To(Succeed())
+		for orphan := range s.orphanedSince {
+			s.orphanedSince[orphan] = time.Now().Add(-2 * time.Hour)
+		}
+		g.Expect(s.sweep(ctx)).To(Succeed())
+		node := &corev1.Node{}
+		g.Expect(s.Get(ctx, client.ObjectKey{Name: nodeName}, node)).To(Succeed())
+		return node
+	}
+
+	t.Run("taint kept by the KeepTaint node exit policy is not swept", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := context.Background()
+		rule := gpuRule()
+		rule.Spec.NodeExitPolicy = readinessv1alpha1.NodeExitPolicyKeepTaint
+		rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{{NodeName: "relabeled"}}
+		node := gpuNode("relabeled", true)
+		node.Labels = map[string]string{"gpu": "false"}
+		s, _ := newOrphanedTaintSweeper(t, time.Hour, rule, node)
+		s.Controller.ruleCache = map[string]*readinessv1alpha1.NodeReadinessRule{rule.Name: rule}
+		s.Controller.trackRuleNode(rule.Name, node.Name)
+
+		g.Expect(s.Controller.releaseNodeFromOutOfScopeRules(ctx, node)).To(Succeed())
+
+		updated := sweepTwice(g, s, node.Name)
+		g.Expect(updated.Spec.Taints).To(ConsistOf(gpuTaint()))
+		g.Expect(updated.Annotations).To(HaveKeyWithValue(retainedTaintsAnnotationKey, "readiness.k8s.io/gpu-ready:NoSchedule"))
+		g.Expect(s.orphanedSince).To(BeEmpty())
+	})
+
+	t.Run("taint kept by the Retain deletion policy is not swept", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := context.Background()
+		rule := gpuRule()
+		rule.Spec.DeletionPolicy = readinessv1alpha1.DeletionPolicyRetain
+		node := gpuNode("retained", true)
+		s, _ := newOrphanedTaintSweeper(t, time.Hour, node)
+
+		// The rule is gone by the time the sweeper runs.
+		g.Expect(s.Controller.retainRuleTaints(ctx, rule, &corev1.NodeList{Items: []corev1.Node{*node}})).To(Succeed())
+
+		updated := sweepTwice(g, s, node.Name)
+		g.Expect(updated.Spec.Taints).To(ConsistOf(gpuTaint()))
+		g.Expect(s.orphanedSince).To(BeEmpty())
+	})
+
+	t.Run("retained taints no longer on the node are forgotten", func(t *testing.T) {
+		g := NewWithT(t)
+		node := gpuNode("recovered", false)
+		node.Annotations = map[string]string{retainedTaintsAnnotationKey: "readiness.k8s.io/gpu-ready:NoSchedule"}
+		s, _ := newOrphanedTaintSweeper(t, time.Hour, node)
+
+		updated := sweepTwice(g, s, node.Name)
+		g.Expect(updated.Annotations).NotTo(HaveKey(retainedTaintsAnnotationKey))
+	})
+}
@@ -0,0 +1,185 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/nodeoverride"
+)
+
+// overrideExpiresParam is the optional value parameter holding the expiry.
+const overrideExpiresParam = "expires="
+
+// isOverrideAnnotation reports whether key is a global or rule-scoped override annotation.
+func isOverrideAnnotation(key string) bool {
+	return key == nodeoverride.AnnotationKey || strings.HasPrefix(key, nodeoverride.AnnotationPrefix)
+}
+
+// parseOverride parses an override annotation value.
+func parseOverride(key, value string) (readinessv1alpha1.NodeOverride, error) {
+	parts := strings.Split(value, ";")
+	override := readinessv1alpha1.NodeOverride{
+		Action:     readinessv1alpha1.OverrideAction(strings.TrimSpace(parts[0])),
+		Annotation: key,
+	}
+
+	switch override.Action {
+	case readinessv1alpha1.OverrideActionForceHold,
+		readinessv1alpha1.OverrideActionForceRelease,
+		readinessv1alpha1.OverrideActionExempt:
+	default:
+		return readinessv1alpha1.NodeOverride{}, fmt.Errorf("unknown override action %q in annotation %s", parts[0], key)
+	}
+
+	for _, param := range parts[1:] {
+		param = strings.TrimSpace(param)
+		expires, ok := strings.CutPrefix(param, overrideExpiresParam)
+		if !ok {
+			return readinessv1alpha1.NodeOverride{}, fmt.Errorf("unknown override parameter %q in annotation %s", param, key)
+		}
+		t, err := time.Parse(time.RFC3339, expires)
+		if err != nil {
+			return readinessv1alpha1.NodeOverride{}, fmt.Errorf("invalid override expiry in annotation %s: %w", key, err)
+		}
+		override.ExpirationTime = metav1.NewTime(t)
+	}
+
+	return override, nil
+}
+
+// overrideExpired reports whether the override has an expiry that is not after now.
+func overrideExpired(override readinessv1alpha1.NodeOverride, now time.Time) bool {
+	return !override.ExpirationTime.IsZero() && !override.ExpirationTime.After(now)
+}
+
+// resolveNodeOverride returns the override that applies to the rule on the
+// node. A rule-scoped annotation takes precedence over the global one until it
+// expires. The returned override is empty when there is none; expired reports
+// whether an override was found but is no longer in effect.
+func resolveNodeOverride(node *corev1.Node, ruleName string, now time.Time) (override readinessv1alpha1.NodeOverride, expired bool, err error) {
+	for _, key := range []string{nodeoverride.RuleAnnotationKey(ruleName), nodeoverride.AnnotationKey} {
+		value, ok := node.Annotations[key]
+		if !ok {
+			continue
+		}
+		override, err = parseOverride(key, value)
+		if err != nil {
+			return readinessv1alpha1.NodeOverride{}, false, err
+		}
+		if !overrideExpired(override, now) {
+			return override, false, nil
+		}
+		expired = true
+	}
+	return readinessv1alpha1.NodeOverride{}, expired, nil
+}
+
+// resolveOverrideForNode returns the override in effect for the rule on the
+// node. Whenever the override differs from the one recorded in the previous
+// evaluation an event is emitted on the node, leaving an audit trail of
+// operator interventions.
+func (r *RuleReadinessController) resolveOverrideForNode(
+	ctx context.Context,
+	node *corev1.Node,
+	rule *readinessv1alpha1.NodeReadinessRule,
+	previous *readinessv1alpha1.NodeEvaluation,
+) readinessv1alpha1.NodeOverride {
+	log := ctrl.LoggerFrom(ctx)
+
+	override, expired, err := resolveNodeOverride(node, rule.Name, time.Now())
+	if err != nil {
+		log.Error(err, "Ignoring invalid override", "node", node.Name, "rule", rule.Name)
+		r.EventRecorder.Eventf(node, nil, corev1.EventTypeWarning, "OverrideInvalid", "ResolveOverride",
+			"Ignoring override for rule '%s': %v", rule.Name, err)
+	}
+
+	var previousOverride readinessv1alpha1.NodeOverride
+	if previous != nil {
+		previousOverride = previous.Override
+	}
+
+	switch {
+	case overridesEqual(override, previousOverride):
+	case override.Action != "":
+		log.Info("Override applied", "node", node.Name, "rule", rule.Name,
+			"action", override.Action, "annotation", override.Annotation)
+		r.EventRecorder.Eventf(node, nil, corev1.EventTypeNormal, "OverrideApplied", "ApplyOverride",
+			"Override '%s' from annotation '%s' applied to rule '%s'", override.Action, override.Annotation, rule.Name)
+	case expired:
+		log.Info("Override expired", "node", node.Name, "rule", rule.Name, "action", previousOverride.Action)
+		r.EventRecorder.Eventf(node, nil, corev1.EventTypeNormal, "OverrideExpired", "ExpireOverride",
+			"Override '%s' for rule '%s' expired", previousOverride.Action, rule.Name)
+	default:
+		log.Info("Override removed", "node", node.Name, "rule", rule.Name, "action", previousOverride.Action)
+		r.EventRecorder.Eventf(node, nil, corev1.EventTypeNormal, "OverrideRemoved", "RemoveOverride",
+			"Override '%s' for rule '%s' removed", previousOverride.Action, rule.Name)
+	}
+
+	return override
+}
+
+// overridesEqual checks if two overrides are equal.
+func overridesEqual(a, b readinessv1alpha1.NodeOverride) bool {
+	return a.Action == b.Action &&
+		a.Annotation == b.Annotation &&
+		a.ExpirationTime.Equal(&b.ExpirationTime)
+}
+
+// nextOverrideExpiry returns how long until the earliest pending override
+// expiry on the node, so the node can be re-evaluated once it lapses.
+func nextOverrideExpiry(node *corev1.Node, now time.Time) (time.Duration, bool) {
+	var next time.Duration
+	found := false
+	for key, value := range node.Annotations {
+		if !isOverrideAnnotation(key) {
+			continue
+		}
+		override, err := parseOverride(key, value)
+		if err != nil || override.ExpirationTime.IsZero() || overrideExpired(override, now) {
+			continue
+		}
+		if d := override.ExpirationTime.Sub(now); !found || d < next {
+			next = d
+			found = true
+		}
+	}
+	return next, found
+}
+
+// overrideAnnotationsEqual checks if the override annotations of two annotation maps are equal.
+func overrideAnnotationsEqual(a, b map[string]string) bool {
+	for key, value := range a {
+		if isOverrideAnnotation(key) && b[key] != value {
+			return false
+		}
+	}
+	for key := range b {
+		if _, exists := a[key]; isOverrideAnnotation(key) && !exists {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,313 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/nodeoverride"
+)
+
+func TestParseOverride(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		wantAction readinessv1alpha1.OverrideAction
+		wantExpiry time.Time
+		wantErr    bool
+	}{
+		{
+			name:       "bare action",
+			value:      "force-hold",
+			wantAction: readinessv1alpha1.OverrideActionForceHold,
+		},
+		{
+			name:       "action with expiry",
+			value:      "force-release;expires=2026-01-02T15:04:05Z",
+			wantAction: readinessv1alpha1.OverrideActionForceRelease,
+			wantExpiry: time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			name:       "whitespace is ignored",
+			value:      " exempt ; expires=2026-01-02T15:04:05Z",
+			wantAction: readinessv1alpha1.OverrideActionExempt,
+			wantExpiry: time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			name:    "unknown action",
+			value:   "release",
+			wantErr: true,
+		},
+		{
+			name:    "unknown parameter",
+			value:   "force-hold;until=2026-01-02T15:04:05Z",
+			wantErr: true,
+		},
+		{
+			name:    "invalid expiry",
+			value:   "force-hold;expires=tomorrow",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			override, err := parseOverride(nodeoverride.AnnotationKey, tt.value)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(override.Action).To(Equal(tt.wantAction))
+			g.Expect(override.Annotation).To(Equal(nodeoverride.AnnotationKey))
+			g.Expect(override.ExpirationTime.Time.Equal(tt.wantExpiry)).To(BeTrue())
+		})
+	}
+}
+
+func TestResolveNodeOverride(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour).Format(time.RFC3339)
+	future := now.Add(time.Hour).Format(time.RFC3339)
+
+	nodeWith := func(annotations map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", Annotations: annotations}}
+	}
+
+	t.Run("no override", func(t *testing.T) {
+		g := NewWithT(t)
+		override, expired, err := resolveNodeOverride(nodeWith(nil), "rule", now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(expired).To(BeFalse())
+		g.Expect(override.Action).To(BeEmpty())
+	})
+
+	t.Run("global override applies to every rule", func(t *testing.T) {
+		g := NewWithT(t)
+		node := nodeWith(map[string]string{nodeoverride.AnnotationKey: "force-hold"})
+		override, _, err := resolveNodeOverride(node, "rule", now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(override.Action).To(Equal(readinessv1alpha1.OverrideActionForceHold))
+		g.Expect(override.Annotation).To(Equal(nodeoverride.AnnotationKey))
+	})
+
+	t.Run("rule-scoped override takes precedence", func(t *testing.T) {
+		g := NewWithT(t)
+		node := nodeWith(map[string]string{
+			nodeoverride.AnnotationKey:             "force-hold",
+			nodeoverride.RuleAnnotationKey("rule"): "force-release",
+		})
+		override, _, err := resolveNodeOverride(node, "rule", now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(override.Action).To(Equal(readinessv1alpha1.OverrideActionForceRelease))
+
+		override, _, err = resolveNodeOverride(node, "other-rule", now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(override.Action).To(Equal(readinessv1alpha1.OverrideActionForceHold))
+	})
+
+	t.Run("expired override is not in effect", func(t *testing.T) {
+		g := NewWithT(t)
+		node := nodeWith(map[string]string{nodeoverride.RuleAnnotationKey("rule"): "force-release;expires=" + past})
+		override, expired, err := resolveNodeOverride(node, "rule", now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(expired).To(BeTrue())
+		g.Expect(override.Action).To(BeEmpty())
+	})
+
+	t.Run("expired rule-scoped override falls through to the global one", func(t *testing.T) {
+		g := NewWithT(t)
+		node := nodeWith(map[string]string{
+			nodeoverride.AnnotationKey:             "force-hold",
+			nodeoverride.RuleAnnotationKey("rule"): "force-release;expires=" + past,
+		})
+		override, expired, err := resolveNodeOverride(node, "rule", now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(expired).To(BeFalse())
+		g.Expect(override.Action).To(Equal(readinessv1alpha1.OverrideActionForceHold))
+		g.Expect(override.Annotation).To(Equal(nodeoverride.AnnotationKey))
+
+		node.Annotations[nodeoverride.AnnotationKey] = "force-hold;expires=" + past
+		override, expired, err = resolveNodeOverride(node, "rule", now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(expired).To(BeTrue())
+		g.Expect(override.Action).To(BeEmpty())
+	})
+
+	t.Run("unexpired override is in effect", func(t *testing.T) {
+		g := NewWithT(t)
+		node := nodeWith(map[string]string{nodeoverride.RuleAnnotationKey("rule"): "exempt;expires=" + future})
+		override, expired, err := resolveNodeOverride(node, "rule", now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(expired).To(BeFalse())
+		g.Expect(override.Action).To(Equal(readinessv1alpha1.OverrideActionExempt))
+	})
+
+	t.Run("invalid override returns an error", func(t *testing.T) {
+		g := NewWithT(t)
+		node := nodeWith(map[string]string{nodeoverride.AnnotationKey: "hold"})
+		_, _, err := resolveNodeOverride(node, "rule", now)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestNextOverrideExpiry(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		nodeoverride.AnnotationKey:              "force-hold;expires=" + now.Add(2*time.Hour).Format(time.RFC3339),
+		nodeoverride.RuleAnnotationKey("rule"):  "exempt;expires=" + now.Add(30*time.Minute).Format(time.RFC3339),
+		nodeoverride.RuleAnnotationKey("other"): "exempt;expires=" + now.Add(-time.Minute).Format(time.RFC3339),
+		"unrelated":                        "force-hold;expires=" + now.Add(time.Minute).Format(time.RFC3339),
+	}}}
+
+	next, ok := nextOverrideExpiry(node, now)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(next).To(Equal(30 * time.Minute))
+
+	_, ok = nextOverrideExpiry(&corev1.Node{}, now)
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestOverrideAnnotationsEqual(t *testing.T) {
+	g := NewWithT(t)
+
+	base := map[string]string{nodeoverride.AnnotationKey: "force-hold", "other": "a"}
+	g.Expect(overrideAnnotationsEqual(base, map[string]string{nodeoverride.AnnotationKey: "force-hold", "other": "b"})).To(BeTrue())
+	g.Expect(overrideAnnotationsEqual(base, map[string]string{nodeoverride.AnnotationKey: "exempt"})).To(BeFalse())
+	g.Expect(overrideAnnotationsEqual(base, map[string]string{"other": "a"})).To(BeFalse())
+	g.Expect(overrideAnnotationsEqual(nil, map[string]string{nodeoverride.RuleAnnotationKey("rule"): "exempt"})).To(BeFalse())
+}
+
+func TestEvaluateRuleForNode_Overrides(t *testing.T) {
+	newRule := func() *readinessv1alpha1.NodeReadinessRule {
+		rule := gpuRule()
+		rule.Spec.EnforcementMode = readinessv1alpha1.EnforcementModeContinuous
+		rule.Spec.Conditions = []readinessv1alpha1.ConditionRequirement{
+			{Type: "GPUReady", RequiredStatus: corev1.ConditionTrue},
+		}
+		return rule
+	}
+	newNode := func(tainted bool, status corev1.ConditionStatus, annotations map[string]string) *corev1.Node {
+		node := gpuNode("gpu-node", tainted)
+		node.Annotations = annotations
+		node.Status.Conditions = []corev1.NodeCondition{{Type: "GPUReady", Status: status}}
+		return node
+	}
+
+	tests := []struct {
+		name       string
+		node       *corev1.Node
+		wantTaint  bool
+		wantAction readinessv1alpha1.OverrideAction
+		wantEvent  string
+	}{
+		{
+			name:       "force-hold keeps the taint on a ready node",
+			node:       newNode(true, corev1.ConditionTrue, map[string]string{nodeoverride.AnnotationKey: "force-hold"}),
+			wantTaint:  true,
+			wantAction: readinessv1alpha1.OverrideActionForceHold,
+			wantEvent:  "OverrideApplied",
+		},
+		{
+			name:       "force-hold adds the taint to a ready node",
+			node:       newNode(false, corev1.ConditionTrue, map[string]string{nodeoverride.RuleAnnotationKey("gpu-ready"): "force-hold"}),
+			wantTaint:  true,
+			wantAction: readinessv1alpha1.OverrideActionForceHold,
+			wantEvent:  "OverrideApplied",
+		},
+		{
+			name:       "force-release removes the taint from a failing node",
+			node:       newNode(true, corev1.ConditionFalse, map[string]string{nodeoverride.AnnotationKey: "force-release"}),
+			wantTaint:  false,
+			wantAction: readinessv1alpha1.OverrideActionForceRelease,
+			wantEvent:  "OverrideApplied",
+		},
+		{
+			name:       "exempt leaves a failing node untainted",
+			node:       newNode(false, corev1.ConditionFalse, map[string]string{nodeoverride.AnnotationKey: "exempt"}),
+			wantTaint:  false,
+			wantAction: readinessv1alpha1.OverrideActionExempt,
+			wantEvent:  "OverrideApplied",
+		},
+		{
+			name: "expired override is ignored",
+			node: newNode(false, corev1.ConditionFalse, map[string]string{
+				nodeoverride.AnnotationKey: "exempt;expires=" + time.Now().Add(-time.Hour).Format(time.RFC3339),
+			}),
+			wantTaint: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			rule := newRule()
+			fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(tt.node).Build()
+			recorder := events.NewFakeRecorder(10)
+			c := &RuleReadinessController{Client: fc, EventRecorder: recorder}
+
+			g.Expect(c.evaluateRuleForNode(ctx, rule, tt.node)).To(Succeed())
+
+			updated := &corev1.Node{}
+			g.Expect(fc.Get(ctx, client.ObjectKey{Name: tt.node.Name}, updated)).To(Succeed())
+			g.Expect(c.hasTaintBySpec(updated, rule.Spec.Taint)).To(Equal(tt.wantTaint))
+
+			eval := c.getPreviousNodeEvaluation(rule, tt.node.Name)
+			g.Expect(eval).NotTo(BeNil())
+			g.Expect(eval.Override.Action).To(Equal(tt.wantAction))
+
+			if tt.wantEvent != "" {
+				g.Expect(recorder.Events).To(Receive(ContainSubstring(tt.wantEvent)))
+			}
+		})
+	}
+
+	t.Run("removing an override emits an event", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := context.Background()
+		rule := newRule()
+		rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{{
+			NodeName: "gpu-node",
+			Override: readinessv1alpha1.NodeOverride{
+				Action:     readinessv1alpha1.OverrideActionForceHold,
+				Annotation: nodeoverride.AnnotationKey,
+			},
+		}}
+		node := newNode(true, corev1.ConditionTrue, nil)
+		fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+		recorder := events.NewFakeRecorder(10)
+		c := &RuleReadinessController{Client: fc, EventRecorder: recorder}
+
+		g.Expect(c.evaluateRuleForNode(ctx, rule, node)).To(Succeed())
+		g.Expect(recorder.Events).To(Receive(ContainSubstring("OverrideRemoved")))
+		g.Expect(c.getPreviousNodeEvaluation(rule, node.Name).Override.Action).To(BeEmpty())
+	})
+}
@@ -0,0 +1,142 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/metrics"
+)
+
+// rearmBootstrapAnnotationKey re-arms bootstrap of a Node for every rule with
+// the Annotation trigger. The controller removes the annotation once all rules
+// have seen it.
+const rearmBootstrapAnnotationKey = "readiness.k8s.io/rearm-bootstrap"
+
+// hasRearmBootstrapAnnotation reports whether an operator asked to bootstrap the Node again.
+func hasRearmBootstrapAnnotation(node *corev1.Node) bool {
+	_, exists := node.Annotations[rearmBootstrapAnnotationKey]
+	return exists
+}
+
+// bootstrapRearmTrigger returns the first of the rule's re-arm triggers that
+// fired since the node completed bootstrap for the rule. Boot IDs and kubelet
+// versions are compared with those recorded in the completion annotation, so
+// completions recorded without them are only re-armed by the annotation.
+func (r *RuleReadinessController) bootstrapRearmTrigger(rule *readinessv1alpha1.NodeReadinessRule, node *corev1.Node) (readinessv1alpha1.BootstrapRearmTrigger, bool) {
+	value, ok := r.bootstrapCompletionAnnotation(node, rule.Name, rule.GetUID())
+	if !ok {
+		return "", false
+	}
+	completion := parseBootstrapAnnotationValue(value)
+	info := node.Status.NodeInfo
+
+	for _, trigger := range rule.Spec.BootstrapRearmTriggers {
+		switch trigger {
+		case readinessv1alpha1.BootstrapRearmTriggerNodeReboot:
+			if completion.BootID != "" && info.BootID != "" && completion.BootID != info.BootID {
+				return trigger, true
+			}
+		case readinessv1alpha1.BootstrapRearmTriggerKubeletUpgrade:
+			if completion.KubeletVersion != "" && info.KubeletVersion != "" && completion.KubeletVersion != info.KubeletVersion {
+				return trigger, true
+			}
+		case readinessv1alpha1.BootstrapRearmTriggerAnnotation:
+			if hasRearmBootstrapAnnotation(node) {
+				return trigger, true
+			}
+		}
+	}
+	return "", false
+}
+
+// rearmBootstrapIfTriggered re-arms bootstrap of a bootstrap-only rule on the
+// node when one of its triggers fired: the completion annotations are dropped
+// and the taint is applied again in the same patch, so that the node is held
+// until the rule's conditions are met again. It returns whether bootstrap was
+// re-armed. Nodes the rule is not enforced on, in dry run or outside its
+// rollout subset, and nodes exempted from the rule or outside its node scope
+// are left untouched.
+func (r *RuleReadinessController) rearmBootstrapIfTriggered(ctx context.Context, node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule) (bool, error) {
+	if rule.Spec.EnforcementMode != readinessv1alpha1.EnforcementModeBootstrapOnly ||
+		len(rule.Spec.BootstrapRearmTriggers) == 0 {
+		return false, nil
+	}
+	if rule.Spec.DryRun || !inRolloutSubset(rule, node.Name) {
+		return false, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	var trigger readinessv1alpha1.BootstrapRearmTrigger
+	rearmed := false
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		rearmed = false
+
+		latestNode := &corev1.Node{}
+		if err := r.Get(ctx, client.ObjectKey{Name: node.Name}, latestNode); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		var fired bool
+		if trigger, fired = r.bootstrapRearmTrigger(rule, latestNode); !fired {
+			return nil
+		}
+		if override, _, _ := resolveNodeOverride(latestNode, rule.Name, time.Now()); override.Action == readinessv1alpha1.OverrideActionExempt {
+			return nil
+		}
+		if !nodeInNodeScope(rule, latestNode) {
+			return nil
+		}
+
+		stored := latestNode.DeepCopy()
+		delete(latestNode.Annotations, bootstrapAnnotationKey(rule.GetUID()))
+		delete(latestNode.Annotations, legacyBootstrapAnnotationKey(rule.Name))
+		if !r.hasRuleTaint(latestNode, rule) {
+			taint := rule.Spec.Taint
+			taint.TimeAdded = &metav1.Time{Time: time.Now()}
+			latestNode.Spec.Taints = append(latestNode.Spec.Taints, taint)
+		}
+		if err := r.Patch(ctx, latestNode, client.MergeFromWithOptions(stored, client.MergeFromWithOptimisticLock{})); err != nil {
+			return err
+		}
+
+		// Update the original node reference with the latest state
+		*node = *latestNode
+
+		rearmed = true
+		return nil
+	})
+	if err != nil || !rearmed {
+		return false, err
+	}
+
+	log.Info("Re-armed bootstrap", "node", node.Name, "rule", rule.Name, "trigger", trigger)
+	metrics.BootstrapRearmed.WithLabelValues(rule.Name, string(trigger)).Inc()
+	r.EventRecorder.Eventf(node, nil, corev1.EventTypeNormal, "BootstrapRearmed", "RearmBootstrap",
+		"Bootstrap of rule '%s' re-armed by the %s trigger, taint '%s:%s' applied again",
+		rule.Name, trigger, rule.Spec.Taint.Key, rule.Spec.Taint.Effect)
+	return true, nil
+}
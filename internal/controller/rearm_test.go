@@ -0,0 +1,196 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/nodeoverride"
+)
+
+// rearmableRule returns a bootstrap-only rule re-armed by the given triggers.
+func rearmableRule(triggers ...readinessv1alpha1.BootstrapRearmTrigger) *readinessv1alpha1.NodeReadinessRule {
+	rule := gpuRule()
+	rule.UID = "gpu-uid"
+	rule.Spec.EnforcementMode = readinessv1alpha1.EnforcementModeBootstrapOnly
+	rule.Spec.BootstrapRearmTriggers = triggers
+	return rule
+}
+
+// completedNode returns a node that completed bootstrap for the gpu rule on
+// boot-1 with kubelet v1.35.0, and now runs the given boot and kubelet version.
+func completedNode(bootID, kubeletVersion string) *corev1.Node {
+	node := gpuNode("gpu-node", false)
+	node.Annotations = map[string]string{
+		bootstrapAnnotationKey("gpu-uid"): bootstrapCompletion{RuleName: "gpu-ready", BootID: "boot-1", KubeletVersion: "v1.35.0"}.String(),
+	}
+	node.Status.NodeInfo = corev1.NodeSystemInfo{BootID: bootID, KubeletVersion: kubeletVersion}
+	return node
+}
+
+func TestBootstrapRearmTrigger(t *testing.T) {
+	annotated := completedNode("boot-1", "v1.35.0")
+	annotated.Annotations[rearmBootstrapAnnotationKey] = ""
+	legacy := gpuNode("gpu-node", false)
+	legacy.Annotations = map[string]string{legacyBootstrapAnnotationKey("gpu-ready"): "true"}
+	legacy.Status.NodeInfo.BootID = "boot-2"
+
+	tests := []struct {
+		name     string
+		triggers []readinessv1alpha1.BootstrapRearmTrigger
+		node     *corev1.Node
+		want     readinessv1alpha1.BootstrapRearmTrigger
+		fired    bool
+	}{
+		{
+			name:     "node rebooted",
+			triggers: []readinessv1alpha1.BootstrapRearmTrigger{readinessv1alpha1.BootstrapRearmTriggerNodeReboot},
+			node:     completedNode("boot-2", "v1.35.0"),
+			want:     readinessv1alpha1.BootstrapRearmTriggerNodeReboot,
+			fired:    true,
+		},
+		{
+			name:     "node rebooted without the trigger",
+			triggers: []readinessv1alpha1.BootstrapRearmTrigger{readinessv1alpha1.BootstrapRearmTriggerKubeletUpgrade},
+			node:     completedNode("boot-2", "v1.35.0"),
+		},
+		{
+			name: "kubelet upgraded",
+			triggers: []readinessv1alpha1.BootstrapRearmTrigger{
+				readinessv1alpha1.BootstrapRearmTriggerNodeReboot,
+				readinessv1alpha1.BootstrapRearmTriggerKubeletUpgrade,
+			},
+			node:  completedNode("boot-1", "v1.36.0"),
+			want:  readinessv1alpha1.BootstrapRearmTriggerKubeletUpgrade,
+			fired: true,
+		},
+		{
+			name:     "annotation",
+			triggers: []readinessv1alpha1.BootstrapRearmTrigger{readinessv1alpha1.BootstrapRearmTriggerAnnotation},
+			node:     annotated,
+			want:     readinessv1alpha1.BootstrapRearmTriggerAnnotation,
+			fired:    true,
+		},
+		{
+			name:     "nothing changed",
+			triggers: []readinessv1alpha1.BootstrapRearmTrigger{readinessv1alpha1.BootstrapRearmTriggerNodeReboot, readinessv1alpha1.BootstrapRearmTriggerAnnotation},
+			node:     completedNode("boot-1", "v1.35.0"),
+		},
+		{
+			name:     "bootstrap not completed",
+			triggers: []readinessv1alpha1.BootstrapRearmTrigger{readinessv1alpha1.BootstrapRearmTriggerNodeReboot},
+			node:     gpuNode("gpu-node", true),
+		},
+		{
+			name:     "legacy completion without a recorded boot ID",
+			triggers: []readinessv1alpha1.BootstrapRearmTrigger{readinessv1alpha1.BootstrapRearmTriggerNodeReboot},
+			node:     legacy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			trigger, fired := (&RuleReadinessController{}).bootstrapRearmTrigger(rearmableRule(tt.triggers...), tt.node)
+			g.Expect(fired).To(Equal(tt.fired))
+			g.Expect(trigger).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestRearmBootstrapIfTriggered(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("drops the completion and applies the taint again", func(t *testing.T) {
+		g := NewWithT(t)
+		node := completedNode("boot-2", "v1.35.0")
+		node.Annotations[legacyBootstrapAnnotationKey("gpu-ready")] = "true"
+		fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+		recorder := events.NewFakeRecorder(10)
+		c := &RuleReadinessController{Client: fc, EventRecorder: recorder}
+
+		rearmed, err := c.rearmBootstrapIfTriggered(ctx, node, rearmableRule(readinessv1alpha1.BootstrapRearmTriggerNodeReboot))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rearmed).To(BeTrue())
+
+		updated := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+		g.Expect(updated.Annotations).To(BeEmpty())
+		g.Expect(updated.Spec.Taints).To(HaveLen(1))
+		g.Expect(updated.Spec.Taints[0].Key).To(Equal(gpuTaint().Key))
+		g.Expect(updated.Spec.Taints[0].TimeAdded).NotTo(BeNil())
+		g.Expect(<-recorder.Events).To(ContainSubstring("BootstrapRearmed"))
+
+		// Once re-armed, the trigger no longer fires.
+		rearmed, err = c.rearmBootstrapIfTriggered(ctx, node, rearmableRule(readinessv1alpha1.BootstrapRearmTriggerNodeReboot))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rearmed).To(BeFalse())
+	})
+
+	t.Run("leaves exempt nodes untouched", func(t *testing.T) {
+		g := NewWithT(t)
+		node := completedNode("boot-2", "v1.35.0")
+		node.Annotations[nodeoverride.RuleAnnotationKey("gpu-ready")] = string(readinessv1alpha1.OverrideActionExempt)
+		fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+		c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+
+		rearmed, err := c.rearmBootstrapIfTriggered(ctx, node, rearmableRule(readinessv1alpha1.BootstrapRearmTriggerNodeReboot))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(rearmed).To(BeFalse())
+
+		updated := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+		g.Expect(updated.Annotations).To(HaveKey(bootstrapAnnotationKey("gpu-uid")))
+		g.Expect(updated.Spec.Taints).To(BeEmpty())
+	})
+
+	t.Run("leaves nodes the rule is not enforced on untouched", func(t *testing.T) {
+		dryRun := rearmableRule(readinessv1alpha1.BootstrapRearmTriggerNodeReboot)
+		dryRun.Spec.DryRun = true
+		outOfRollout := rearmableRule(readinessv1alpha1.BootstrapRearmTriggerNodeReboot)
+		outOfRollout.Spec.Rollout = rolloutRule().Spec.Rollout
+
+		for name, rule := range map[string]*readinessv1alpha1.NodeReadinessRule{
+			"dry run":                    dryRun,
+			"outside the rollout subset": outOfRollout,
+		} {
+			t.Run(name, func(t *testing.T) {
+				g := NewWithT(t)
+				node := completedNode("boot-2", "v1.35.0")
+				fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+				c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+
+				rearmed, err := c.rearmBootstrapIfTriggered(ctx, node, rule)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(rearmed).To(BeFalse())
+
+				updated := &corev1.Node{}
+				g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+				g.Expect(updated.Annotations).To(HaveKey(bootstrapAnnotationKey("gpu-uid")))
+				g.Expect(updated.Spec.Taints).To(BeEmpty())
+			})
+		}
+	})
+}
@@ -0,0 +1,206 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// rolloutRecheckInterval is how often a blocked rollout re-checks its thresholds.
+const rolloutRecheckInterval = time.Minute
+
+// hasRollout reports whether the rule is enforced progressively.
+func hasRollout(rule *readinessv1alpha1.NodeReadinessRule) bool {
+	return len(rule.Spec.Rollout.Stages) > 0
+}
+
+// rolloutPercent returns the percentage of the matching nodes the rule is
+// currently enforced on.
+func rolloutPercent(rule *readinessv1alpha1.NodeReadinessRule) int32 {
+	if !hasRollout(rule) {
+		return 100
+	}
+	if percent := rule.Status.Rollout.Percent; percent > 0 {
+		return percent
+	}
+	return rule.Spec.Rollout.Stages[0].Percent
+}
+
+// inRolloutSubset reports whether the rule is enforced on the node. Nodes are
+// picked by a stable hash of the rule and node names, so that a higher
+// percentage always enforces the rule on a superset of the nodes.
+func inRolloutSubset(rule *readinessv1alpha1.NodeReadinessRule, nodeName string) bool {
+	percent := rolloutPercent(rule)
+	if percent >= 100 {
+		return true
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(rule.Name + "/" + nodeName))
+	return int32(h.Sum32()%100) < percent
+}
+
+// splitRolloutNodes splits the nodes into those the rule is enforced on and
+// those only accounted for in dry run.
+func splitRolloutNodes(rule *readinessv1alpha1.NodeReadinessRule, nodeList *corev1.NodeList) (*corev1.NodeList, *corev1.NodeList) {
+	enforced, rest := &corev1.NodeList{}, &corev1.NodeList{}
+	for _, node := range nodeList.Items {
+		if inRolloutSubset(rule, node.Name) {
+			enforced.Items = append(enforced.Items, node)
+		} else {
+			rest.Items = append(rest.Items, node)
+		}
+	}
+	return enforced, rest
+}
+
+// releaseRolloutNodes releases the nodes the rule's status has entries for
+// but that are no longer in the rollout subset, e.g. after the percent of the
+// current stage was lowered, so that they do not stay tainted. The released
+// nodes are dropped from the rule's status in place as well.
+func (r *RuleReadinessController) releaseRolloutNodes(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeList *corev1.NodeList) error {
+	var errs []error
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if inRolloutSubset(rule, node.Name) || !nodeInRuleStatus(rule, node.Name) {
+			continue
+		}
+		if err := r.releaseNodeFromRule(ctx, rule, node, "NodeLeftRollout", rolloutExitMessage(rule)); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		dropNodeFromRuleStatus(rule, node.Name)
+	}
+	return errors.Join(errs...)
+}
+
+// rolloutExitMessage explains why a node was released from the rule's rollout.
+func rolloutExitMessage(rule *readinessv1alpha1.NodeReadinessRule) string {
+	return fmt.Sprintf("Node is no longer in the %d%% of the nodes rule '%s' is rolled out to", rolloutPercent(rule), rule.Name)
+}
+
+// rolloutThresholdExceeded returns why the rollout must not advance, or ""
+// if it may.
+func rolloutThresholdExceeded(rollout readinessv1alpha1.Rollout, enforced, failing, newlyTainted int32) string {
+	if rollout.MaxFailurePercent != nil && failing*100 > *rollout.MaxFailurePercent*enforced {
+		return fmt.Sprintf("%d of %d nodes are failing, more than %d%%", failing, enforced, *rollout.MaxFailurePercent)
+	}
+	if rollout.MaxNewlyTaintedNodes != nil && newlyTainted > *rollout.MaxNewlyTaintedNodes {
+		return fmt.Sprintf("%d nodes were tainted during the stage, more than %d", newlyTainted, *rollout.MaxNewlyTaintedNodes)
+	}
+	return ""
+}
+
+// progressRollout accounts for the nodes the rule is enforced on and advances
+// the rollout to its next stage once the current stage's pause has elapsed,
+// unless a threshold is exceeded. It returns how long until the rollout needs
+// to be re-evaluated, or zero if it has completed.
+func (r *RuleReadinessController) progressRollout(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeList *corev1.NodeList, now time.Time) time.Duration {
+	log := ctrl.LoggerFrom(ctx)
+	rollout := rule.Spec.Rollout
+	status := &rule.Status.Rollout
+
+	if !hasRollout(rule) {
+		*status = readinessv1alpha1.RolloutStatus{}
+		return 0
+	}
+	if status.CurrentStage < 1 {
+		status.CurrentStage = 1
+		status.StageStartTime = metav1.NewTime(now)
+		status.Phase = readinessv1alpha1.RolloutPhaseProgressing
+	}
+	// The stages may have been shortened since.
+	status.CurrentStage = min(status.CurrentStage, int32(len(rollout.Stages)))
+	stage := rollout.Stages[status.CurrentStage-1]
+	status.Percent = stage.Percent
+
+	// Tainted nodes are expected while they are not ready; only nodes the
+	// rule failed to evaluate count as failing. Nodes tainted during the
+	// stage count as newly tainted even once the taint was removed again.
+	var enforced, failing, newlyTainted int32
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if !r.ruleAppliesTo(ctx, rule, node) || !inRolloutSubset(rule, node.Name) {
+			continue
+		}
+		enforced++
+		if slices.ContainsFunc(rule.Status.FailedNodes, func(f readinessv1alpha1.NodeFailure) bool {
+			return f.NodeName == node.Name
+		}) {
+			failing++
+		}
+		if eval := r.getPreviousNodeEvaluation(rule, node.Name); eval != nil && !eval.TaintAddedTime.IsZero() &&
+			!eval.TaintAddedTime.Before(&status.StageStartTime) {
+			newlyTainted++
+		}
+	}
+	status.EnforcedNodes = &enforced
+	status.FailingNodes = &failing
+	status.NewlyTaintedNodes = &newlyTainted
+
+	if int(status.CurrentStage) == len(rollout.Stages) {
+		status.Phase = readinessv1alpha1.RolloutPhaseCompleted
+		status.Message = ""
+		return 0
+	}
+
+	if remaining := status.StageStartTime.Add(time.Duration(stage.PauseSeconds) * time.Second).Sub(now); remaining > 0 {
+		status.Phase = readinessv1alpha1.RolloutPhaseProgressing
+		status.Message = ""
+		return remaining
+	}
+
+	if reason := rolloutThresholdExceeded(rollout, enforced, failing, newlyTainted); reason != "" {
+		if status.Phase != readinessv1alpha1.RolloutPhaseBlocked {
+			log.Info("Rollout blocked", "rule", rule.Name, "stage", status.CurrentStage, "reason", reason)
+			r.EventRecorder.Eventf(rule, nil, corev1.EventTypeWarning, "RolloutBlocked", "AdvanceRollout",
+				"Rollout blocked at stage %d (%d%%): %s", status.CurrentStage, status.Percent, reason)
+		}
+		status.Phase = readinessv1alpha1.RolloutPhaseBlocked
+		status.Message = reason
+		return rolloutRecheckInterval
+	}
+
+	status.CurrentStage++
+	stage = rollout.Stages[status.CurrentStage-1]
+	status.Percent = stage.Percent
+	status.StageStartTime = metav1.NewTime(now)
+	status.Phase = readinessv1alpha1.RolloutPhaseProgressing
+	status.Message = ""
+	// Only nodes tainted from now on count against the new stage.
+	newlyTainted = 0
+	status.NewlyTaintedNodes = &newlyTainted
+	log.Info("Rollout advanced", "rule", rule.Name, "stage", status.CurrentStage, "percent", status.Percent)
+	r.EventRecorder.Eventf(rule, nil, corev1.EventTypeNormal, "RolloutAdvanced", "AdvanceRollout",
+		"Rollout advanced to stage %d, enforcing the rule on %d%% of the nodes", status.CurrentStage, status.Percent)
+
+	if int(status.CurrentStage) == len(rollout.Stages) {
+		status.Phase = readinessv1alpha1.RolloutPhaseCompleted
+		return 0
+	}
+	return time.Duration(stage.PauseSeconds) * time.Second
+}
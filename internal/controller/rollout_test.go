@@ -0,0 +1,282 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// rolloutRule returns the gpu rule rolled out in stages of 10, 50 and 100
+// percent, an hour apart.
+func rolloutRule() *readinessv1alpha1.NodeReadinessRule {
+	rule := gpuRule()
+	rule.Spec.Rollout = readinessv1alpha1.Rollout{Stages: []readinessv1alpha1.RolloutStage{
+		{Percent: 10, PauseSeconds: 3600},
+		{Percent: 50, PauseSeconds: 3600},
+		{Percent: 100},
+	}}
+	return rule
+}
+
+func TestInRolloutSubset(t *testing.T) {
+	g := NewWithT(t)
+	rule := rolloutRule()
+
+	subsets := map[int32]int{}
+	for _, percent := range []int32{10, 50, 100} {
+		rule.Status.Rollout.Percent = percent
+		for i := range 1000 {
+			name := fmt.Sprintf("node-%d", i)
+			if !inRolloutSubset(rule, name) {
+				continue
+			}
+			subsets[percent]++
+			// A node stays in the subset as the rollout advances.
+			for _, higher := range []int32{50, 100} {
+				if higher > percent {
+					g.Expect(inRolloutSubset(&readinessv1alpha1.NodeReadinessRule{
+						ObjectMeta: rule.ObjectMeta,
+						Spec:       rule.Spec,
+						Status:     readinessv1alpha1.NodeReadinessRuleStatus{Rollout: readinessv1alpha1.RolloutStatus{Percent: higher}},
+					}, name)).To(BeTrue())
+				}
+			}
+		}
+	}
+	g.Expect(subsets[10]).To(BeNumerically("~", 100, 40))
+	g.Expect(subsets[50]).To(BeNumerically("~", 500, 80))
+	g.Expect(subsets[100]).To(Equal(1000))
+
+	// Without a rollout, the rule is enforced on every node.
+	g.Expect(inRolloutSubset(gpuRule(), "node-0")).To(BeTrue())
+}
+
+func TestProgressRollout(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name         string
+		rollout      func(*readinessv1alpha1.Rollout)
+		status       readinessv1alpha1.RolloutStatus
+		tainted      bool
+		failed       bool
+		recovered    bool
+		wantStage    int32
+		wantPhase    readinessv1alpha1.RolloutPhase
+		wantRequeue  time.Duration
+		wantEvent    string
+		wantEnforced bool
+	}{
+		{
+			name:        "starts at the first stage",
+			wantStage:   1,
+			wantPhase:   readinessv1alpha1.RolloutPhaseProgressing,
+			wantRequeue: time.Hour,
+		},
+		{
+			name: "waits for the stage's pause",
+			status: readinessv1alpha1.RolloutStatus{
+				CurrentStage:   1,
+				StageStartTime: metav1.NewTime(now.Add(-30 * time.Minute)),
+			},
+			wantStage:   1,
+			wantPhase:   readinessv1alpha1.RolloutPhaseProgressing,
+			wantRequeue: 30 * time.Minute,
+		},
+		{
+			name: "advances once the pause has elapsed",
+			status: readinessv1alpha1.RolloutStatus{
+				CurrentStage:   1,
+				StageStartTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+			},
+			tainted:     true,
+			wantStage:   2,
+			wantPhase:   readinessv1alpha1.RolloutPhaseProgressing,
+			wantRequeue: time.Hour,
+			wantEvent:   "RolloutAdvanced",
+		},
+		{
+			name:    "blocked by failing nodes",
+			rollout: func(r *readinessv1alpha1.Rollout) { r.MaxFailurePercent = ptr.To[int32](20) },
+			status: readinessv1alpha1.RolloutStatus{
+				CurrentStage:   1,
+				StageStartTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+			},
+			failed:      true,
+			wantStage:   1,
+			wantPhase:   readinessv1alpha1.RolloutPhaseBlocked,
+			wantRequeue: rolloutRecheckInterval,
+			wantEvent:   "RolloutBlocked",
+		},
+		{
+			name:    "tainted nodes do not count as failing",
+			rollout: func(r *readinessv1alpha1.Rollout) { r.MaxFailurePercent = ptr.To[int32](20) },
+			status: readinessv1alpha1.RolloutStatus{
+				CurrentStage:   1,
+				StageStartTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+			},
+			tainted:     true,
+			wantStage:   2,
+			wantPhase:   readinessv1alpha1.RolloutPhaseProgressing,
+			wantRequeue: time.Hour,
+			wantEvent:   "RolloutAdvanced",
+		},
+		{
+			name:    "blocked by newly tainted nodes that recovered",
+			rollout: func(r *readinessv1alpha1.Rollout) { r.MaxNewlyTaintedNodes = ptr.To[int32](0) },
+			status: readinessv1alpha1.RolloutStatus{
+				CurrentStage:   1,
+				StageStartTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+			},
+			recovered:   true,
+			wantStage:   1,
+			wantPhase:   readinessv1alpha1.RolloutPhaseBlocked,
+			wantRequeue: rolloutRecheckInterval,
+			wantEvent:   "RolloutBlocked",
+		},
+		{
+			name:    "blocked by newly tainted nodes",
+			rollout: func(r *readinessv1alpha1.Rollout) { r.MaxNewlyTaintedNodes = ptr.To[int32](0) },
+			status: readinessv1alpha1.RolloutStatus{
+				CurrentStage:   1,
+				StageStartTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+			},
+			tainted:     true,
+			wantStage:   1,
+			wantPhase:   readinessv1alpha1.RolloutPhaseBlocked,
+			wantRequeue: rolloutRecheckInterval,
+			wantEvent:   "RolloutBlocked",
+		},
+		{
+			name: "completes at the last stage",
+			status: readinessv1alpha1.RolloutStatus{
+				CurrentStage:   2,
+				StageStartTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+			},
+			wantStage:    3,
+			wantPhase:    readinessv1alpha1.RolloutPhaseCompleted,
+			wantEvent:    "RolloutAdvanced",
+			wantEnforced: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			rule := rolloutRule()
+			if tt.rollout != nil {
+				tt.rollout(&rule.Spec.Rollout)
+			}
+			rule.Status.Rollout = tt.status
+
+			nodeList := &corev1.NodeList{}
+			for i := range 100 {
+				node := gpuNode(fmt.Sprintf("node-%d", i), tt.tainted)
+				nodeList.Items = append(nodeList.Items, *node)
+				eval := readinessv1alpha1.NodeEvaluation{NodeName: node.Name, TaintStatus: taintStatusOf(tt.tainted)}
+				if tt.tainted || tt.recovered {
+					eval.TaintAddedTime = metav1.NewTime(now.Add(-time.Hour))
+				}
+				rule.Status.NodeEvaluations = append(rule.Status.NodeEvaluations, eval)
+				if tt.failed {
+					rule.Status.FailedNodes = append(rule.Status.FailedNodes, readinessv1alpha1.NodeFailure{NodeName: node.Name})
+				}
+			}
+			recorder := events.NewFakeRecorder(10)
+			r := &RuleReadinessController{EventRecorder: recorder}
+
+			requeueAfter := r.progressRollout(t.Context(), rule, nodeList, now)
+			g.Expect(rule.Status.Rollout.CurrentStage).To(Equal(tt.wantStage))
+			g.Expect(rule.Status.Rollout.Percent).To(Equal(rule.Spec.Rollout.Stages[tt.wantStage-1].Percent))
+			g.Expect(rule.Status.Rollout.Phase).To(Equal(tt.wantPhase))
+			g.Expect(requeueAfter).To(BeNumerically("~", tt.wantRequeue, time.Second))
+			g.Expect(*rule.Status.Rollout.EnforcedNodes).To(BeNumerically(">", 0))
+
+			if tt.wantEvent == "" {
+				g.Expect(recorder.Events).To(BeEmpty())
+			} else {
+				g.Expect(<-recorder.Events).To(ContainSubstring(tt.wantEvent))
+			}
+
+			enforced, rest := splitRolloutNodes(rule, nodeList)
+			g.Expect(len(enforced.Items) + len(rest.Items)).To(Equal(len(nodeList.Items)))
+			g.Expect(rest.Items == nil).To(Equal(tt.wantEnforced))
+		})
+	}
+}
+
+func TestReleaseRolloutNodes(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	// The rollout was enforced on every node, then scaled back to 10%.
+	rule := rolloutRule()
+	rule.Status.Rollout = readinessv1alpha1.RolloutStatus{CurrentStage: 1, Percent: 10}
+	var nodes []client.Object
+	nodeList := &corev1.NodeList{}
+	for i := range 20 {
+		node := gpuNode(fmt.Sprintf("node-%d", i), true)
+		nodes = append(nodes, node)
+		nodeList.Items = append(nodeList.Items, *node)
+		rule.Status.NodeEvaluations = append(rule.Status.NodeEvaluations, readinessv1alpha1.NodeEvaluation{
+			NodeName: node.Name, TaintStatus: readinessv1alpha1.TaintStatusPresent,
+		})
+	}
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).
+		WithObjects(append(nodes, rule)...).WithStatusSubresource(rule).Build()
+	recorder := events.NewFakeRecorder(100)
+	c := &RuleReadinessController{
+		Client:        fc,
+		EventRecorder: recorder,
+		ruleCache:     map[string]*readinessv1alpha1.NodeReadinessRule{rule.Name: rule},
+	}
+
+	enforced, rest := splitRolloutNodes(rule, nodeList)
+	g.Expect(rest.Items).NotTo(BeEmpty())
+	g.Expect(c.releaseRolloutNodes(ctx, rule, rest)).To(Succeed())
+
+	// Only the nodes left out of the rollout are released.
+	for _, node := range rest.Items {
+		updatedNode := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updatedNode)).To(Succeed())
+		g.Expect(c.hasTaintBySpec(updatedNode, gpuTaint())).To(BeFalse())
+		g.Expect(nodeInRuleStatus(rule, node.Name)).To(BeFalse())
+	}
+	for _, node := range enforced.Items {
+		updatedNode := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updatedNode)).To(Succeed())
+		g.Expect(c.hasTaintBySpec(updatedNode, gpuTaint())).To(BeTrue())
+		g.Expect(nodeInRuleStatus(rule, node.Name)).To(BeTrue())
+	}
+	var received []string
+	for len(recorder.Events) > 0 {
+		received = append(received, <-recorder.Events)
+	}
+	g.Expect(received).To(ContainElement(ContainSubstring("NodeLeftRollout")))
+}
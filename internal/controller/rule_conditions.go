@@ -0,0 +1,99 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// setRuleConditions computes the Ready, Progressing and Degraded conditions
+// of the rule from the rest of its status.
+func setRuleConditions(rule *readinessv1alpha1.NodeReadinessRule) {
+	observed := rule.Status.ObservedGeneration == rule.Generation
+
+	degraded := metav1.Condition{
+		Type:    readinessv1alpha1.RuleConditionDegraded,
+		Status:  metav1.ConditionFalse,
+		Reason:  readinessv1alpha1.RuleReasonAsExpected,
+		Message: "Rule is evaluated for all of its Nodes",
+	}
+	if _, err := metav1.LabelSelectorAsSelector(&rule.Spec.NodeSelector); err != nil {
+		degraded.Status = metav1.ConditionTrue
+		degraded.Reason = readinessv1alpha1.RuleReasonInvalidNodeSelector
+		degraded.Message = fmt.Sprintf("Invalid nodeSelector: %v", err)
+	} else if failed := max(int32(len(rule.Status.FailedNodes)), ptr.Deref(rule.Status.NodeCounts.Failed, 0)); failed > 0 {
+		degraded.Status = metav1.ConditionTrue
+		degraded.Reason = readinessv1alpha1.RuleReasonNodeEvaluationFailed
+		degraded.Message = fmt.Sprintf("%d Nodes failed evaluation", failed)
+	} else if rule.Status.Rollout.Phase == readinessv1alpha1.RolloutPhaseBlocked {
+		degraded.Status = metav1.ConditionTrue
+		degraded.Reason = readinessv1alpha1.RuleReasonRolloutBlocked
+		degraded.Message = rule.Status.Rollout.Message
+	}
+
+	progressing := metav1.Condition{
+		Type:    readinessv1alpha1.RuleConditionProgressing,
+		Status:  metav1.ConditionFalse,
+		Reason:  readinessv1alpha1.RuleReasonReconciled,
+		Message: "Latest spec is reconciled",
+	}
+	switch {
+	case !observed:
+		progressing.Status = metav1.ConditionTrue
+		progressing.Reason = readinessv1alpha1.RuleReasonReconciling
+		progressing.Message = fmt.Sprintf("Generation %d is not reconciled yet", rule.Generation)
+	case rule.Status.Rollout.Phase == readinessv1alpha1.RolloutPhaseProgressing:
+		progressing.Status = metav1.ConditionTrue
+		progressing.Reason = readinessv1alpha1.RuleReasonRollingOut
+		progressing.Message = fmt.Sprintf("Rollout is at stage %d, enforcing the rule on %d%% of the Nodes",
+			rule.Status.Rollout.CurrentStage, rule.Status.Rollout.Percent)
+	}
+
+	ready := metav1.Condition{
+		Type:    readinessv1alpha1.RuleConditionReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  readinessv1alpha1.RuleReasonEnforcing,
+		Message: "Rule manages the taint of the Nodes it selects",
+	}
+	switch {
+	case rule.Status.ScheduleState == readinessv1alpha1.ScheduleStateInactive:
+		ready.Reason = readinessv1alpha1.RuleReasonSuspended
+		ready.Message = "Rule is outside its activation windows"
+	case !observed:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = progressing.Reason
+		ready.Message = progressing.Message
+	case degraded.Status == metav1.ConditionTrue:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = degraded.Reason
+		ready.Message = degraded.Message
+	case rule.Spec.DryRun:
+		ready.Reason = readinessv1alpha1.RuleReasonDryRun
+		ready.Message = "Rule only previews taint changes"
+	}
+
+	for _, condition := range []metav1.Condition{ready, progressing, degraded} {
+		condition.ObservedGeneration = rule.Generation
+		meta.SetStatusCondition(&rule.Status.Conditions, condition)
+	}
+}
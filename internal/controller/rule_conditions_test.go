@@ -0,0 +1,134 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+func TestSetRuleConditions(t *testing.T) {
+	tests := []struct {
+		name            string
+		mutate          func(*readinessv1alpha1.NodeReadinessRule)
+		wantReady       metav1.ConditionStatus
+		wantReason      string
+		wantProgressing metav1.ConditionStatus
+		wantDegraded    metav1.ConditionStatus
+	}{
+		{
+			name:            "reconciled",
+			wantReady:       metav1.ConditionTrue,
+			wantReason:      readinessv1alpha1.RuleReasonEnforcing,
+			wantProgressing: metav1.ConditionFalse,
+			wantDegraded:    metav1.ConditionFalse,
+		},
+		{
+			name:            "new generation",
+			mutate:          func(rule *readinessv1alpha1.NodeReadinessRule) { rule.Generation = 3 },
+			wantReady:       metav1.ConditionFalse,
+			wantReason:      readinessv1alpha1.RuleReasonReconciling,
+			wantProgressing: metav1.ConditionTrue,
+			wantDegraded:    metav1.ConditionFalse,
+		},
+		{
+			name:            "dry run",
+			mutate:          func(rule *readinessv1alpha1.NodeReadinessRule) { rule.Spec.DryRun = true },
+			wantReady:       metav1.ConditionTrue,
+			wantReason:      readinessv1alpha1.RuleReasonDryRun,
+			wantProgressing: metav1.ConditionFalse,
+			wantDegraded:    metav1.ConditionFalse,
+		},
+		{
+			name: "failed nodes",
+			mutate: func(rule *readinessv1alpha1.NodeReadinessRule) {
+				rule.Status.FailedNodes = []readinessv1alpha1.NodeFailure{{NodeName: "node-1", Reason: "EvaluationError"}}
+			},
+			wantReady:       metav1.ConditionFalse,
+			wantReason:      readinessv1alpha1.RuleReasonNodeEvaluationFailed,
+			wantProgressing: metav1.ConditionFalse,
+			wantDegraded:    metav1.ConditionTrue,
+		},
+		{
+			name: "invalid selector",
+			mutate: func(rule *readinessv1alpha1.NodeReadinessRule) {
+				rule.Spec.NodeSelector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "gpu", Operator: "Bogus"}}
+			},
+			wantReady:       metav1.ConditionFalse,
+			wantReason:      readinessv1alpha1.RuleReasonInvalidNodeSelector,
+			wantProgressing: metav1.ConditionFalse,
+			wantDegraded:    metav1.ConditionTrue,
+		},
+		{
+			name: "rolling out",
+			mutate: func(rule *readinessv1alpha1.NodeReadinessRule) {
+				rule.Status.Rollout = readinessv1alpha1.RolloutStatus{CurrentStage: 1, Percent: 10, Phase: readinessv1alpha1.RolloutPhaseProgressing}
+			},
+			wantReady:       metav1.ConditionTrue,
+			wantReason:      readinessv1alpha1.RuleReasonEnforcing,
+			wantProgressing: metav1.ConditionTrue,
+			wantDegraded:    metav1.ConditionFalse,
+		},
+		{
+			name: "rollout blocked",
+			mutate: func(rule *readinessv1alpha1.NodeReadinessRule) {
+				rule.Status.Rollout = readinessv1alpha1.RolloutStatus{CurrentStage: 1, Percent: 10, Phase: readinessv1alpha1.RolloutPhaseBlocked}
+			},
+			wantReady:       metav1.ConditionFalse,
+			wantReason:      readinessv1alpha1.RuleReasonRolloutBlocked,
+			wantProgressing: metav1.ConditionFalse,
+			wantDegraded:    metav1.ConditionTrue,
+		},
+		{
+			name: "suspended",
+			mutate: func(rule *readinessv1alpha1.NodeReadinessRule) {
+				rule.Status.ScheduleState = readinessv1alpha1.ScheduleStateInactive
+			},
+			wantReady:       metav1.ConditionTrue,
+			wantReason:      readinessv1alpha1.RuleReasonSuspended,
+			wantProgressing: metav1.ConditionFalse,
+			wantDegraded:    metav1.ConditionFalse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			rule := gpuRule()
+			rule.Generation = 2
+			rule.Status.ObservedGeneration = 2
+			if tt.mutate != nil {
+				tt.mutate(rule)
+			}
+
+			setRuleConditions(rule)
+			g.Expect(rule.Status.Conditions).To(HaveLen(3))
+
+			ready := meta.FindStatusCondition(rule.Status.Conditions, readinessv1alpha1.RuleConditionReady)
+			g.Expect(ready.Status).To(Equal(tt.wantReady))
+			g.Expect(ready.Reason).To(Equal(tt.wantReason))
+			g.Expect(ready.ObservedGeneration).To(Equal(rule.Generation))
+			g.Expect(meta.FindStatusCondition(rule.Status.Conditions, readinessv1alpha1.RuleConditionProgressing).Status).To(Equal(tt.wantProgressing))
+			g.Expect(meta.FindStatusCondition(rule.Status.Conditions, readinessv1alpha1.RuleConditionDegraded).Status).To(Equal(tt.wantDegraded))
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/schedule"
+)
+
+// hasSchedule reports whether the rule limits when it is in effect.
+func hasSchedule(rule *readinessv1alpha1.NodeReadinessRule) bool {
+	return len(rule.Spec.Schedule.ActivationWindows) > 0 || !rule.Spec.Schedule.ExpiresAt.IsZero()
+}
+
+// parsedSchedule is a rule's schedule as parsed at one of its generations.
+type parsedSchedule struct {
+	uid        types.UID
+	generation int64
+	schedule   *schedule.Schedule
+	err        error
+}
+
+// ruleSchedule returns the rule's parsed schedule, parsing it once per
+// generation of the rule rather than on every evaluation.
+func (r *RuleReadinessController) ruleSchedule(rule *readinessv1alpha1.NodeReadinessRule) (*schedule.Schedule, error) {
+	r.schedulesMutex.Lock()
+	defer r.schedulesMutex.Unlock()
+
+	if parsed, ok := r.schedules[rule.Name]; ok && parsed.uid == rule.UID && parsed.generation == rule.Generation {
+		return parsed.schedule, parsed.err
+	}
+	s, err := schedule.Parse(rule.Spec.Schedule)
+	if r.schedules == nil {
+		r.schedules = make(map[string]parsedSchedule)
+	}
+	r.schedules[rule.Name] = parsedSchedule{uid: rule.UID, generation: rule.Generation, schedule: s, err: err}
+	return s, err
+}
+
+// deleteRuleSchedule forgets the parsed schedule of a deleted rule.
+func (r *RuleReadinessController) deleteRuleSchedule(ruleName string) {
+	r.schedulesMutex.Lock()
+	defer r.schedulesMutex.Unlock()
+	delete(r.schedules, ruleName)
+}
+
+// ruleScheduleActive reports whether the rule is in effect at now. A rule
+// whose schedule cannot be parsed is not, as its reconciler fails too.
+func (r *RuleReadinessController) ruleScheduleActive(rule *readinessv1alpha1.NodeReadinessRule, now time.Time) bool {
+	if !hasSchedule(rule) {
+		return true
+	}
+	s, err := r.ruleSchedule(rule)
+	return err == nil && s.Active(now)
+}
+
+// reconcileSchedule deletes the rule once it has expired, and otherwise
+// records in the rule's status whether it is active and when that next
+// changes. It returns whether the rule is in effect and how long until its
+// next transition, or zero if none is due.
+func (r *RuleReconciler) reconcileSchedule(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule) (bool, time.Duration, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if !hasSchedule(rule) {
+		rule.Status.ScheduleState = ""
+		rule.Status.NextTransitionTime = metav1.Time{}
+		return true, 0, nil
+	}
+
+	s, err := r.Controller.ruleSchedule(rule)
+	if err != nil {
+		return false, 0, fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	now := time.Now()
+	if s.Expired(now) {
+		log.Info("Rule expired, deleting it", "rule", rule.Name, "expiresAt", rule.Spec.Schedule.ExpiresAt)
+		r.Controller.EventRecorder.Eventf(rule, nil, corev1.EventTypeNormal, "RuleExpired", "Delete",
+			"Rule expired at %s, deleting it according to its %s deletion policy",
+			rule.Spec.Schedule.ExpiresAt.UTC().Format(time.RFC3339), rule.Spec.GetDeletionPolicy())
+		rule.Status.ScheduleState = readinessv1alpha1.ScheduleStateInactive
+		rule.Status.NextTransitionTime = metav1.Time{}
+		return false, 0, client.IgnoreNotFound(r.Delete(ctx, rule))
+	}
+
+	active := s.Active(now)
+	state := readinessv1alpha1.ScheduleStateInactive
+	if active {
+		state = readinessv1alpha1.ScheduleStateActive
+	}
+	if rule.Status.ScheduleState != "" && rule.Status.ScheduleState != state {
+		log.Info("Rule schedule transitioned", "rule", rule.Name, "state", state)
+		reason, action := "RuleSuspended", "Suspend"
+		if active {
+			reason, action = "RuleActivated", "Activate"
+		}
+		r.Controller.EventRecorder.Eventf(rule, nil, corev1.EventTypeNormal, reason, action,
+			"Rule is now %s according to its schedule", state)
+	}
+	rule.Status.ScheduleState = state
+
+	var requeueAfter time.Duration
+	rule.Status.NextTransitionTime = metav1.Time{}
+	if next := s.NextTransition(now); !next.IsZero() {
+		rule.Status.NextTransitionTime = metav1.NewTime(next)
+		requeueAfter = next.Sub(now)
+	}
+	return active, requeueAfter, nil
+}
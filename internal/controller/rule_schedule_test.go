@@ -0,0 +1,135 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// windowFrom returns an activation window that opened or opens at the start
+// of the minute the given offset away from now, and stays open for an hour.
+func windowFrom(offset time.Duration) readinessv1alpha1.ActivationWindow {
+	start := time.Now().UTC().Add(offset)
+	return readinessv1alpha1.ActivationWindow{
+		Start:           start.Format("4 15 2 1 *"),
+		DurationSeconds: 3600,
+	}
+}
+
+func TestReconcileSchedule(t *testing.T) {
+	tests := []struct {
+		name       string
+		schedule   readinessv1alpha1.RuleSchedule
+		wantActive bool
+		wantState  readinessv1alpha1.ScheduleState
+		wantNext   bool
+		wantEvent  string
+	}{
+		{
+			name:       "no schedule",
+			wantActive: true,
+		},
+		{
+			name:       "within the activation window",
+			schedule:   readinessv1alpha1.RuleSchedule{ActivationWindows: []readinessv1alpha1.ActivationWindow{windowFrom(-10 * time.Minute)}},
+			wantActive: true,
+			wantState:  readinessv1alpha1.ScheduleStateActive,
+			wantNext:   true,
+		},
+		{
+			name:       "outside the activation window",
+			schedule:   readinessv1alpha1.RuleSchedule{ActivationWindows: []readinessv1alpha1.ActivationWindow{windowFrom(10 * time.Minute)}},
+			wantActive: false,
+			wantState:  readinessv1alpha1.ScheduleStateInactive,
+			wantNext:   true,
+			wantEvent:  "RuleSuspended",
+		},
+		{
+			name:       "expired",
+			schedule:   readinessv1alpha1.RuleSchedule{ExpiresAt: metav1.NewTime(time.Now().Add(-time.Minute))},
+			wantActive: false,
+			wantState:  readinessv1alpha1.ScheduleStateInactive,
+			wantEvent:  "RuleExpired",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			rule := gpuRule()
+			rule.Spec.Schedule = tt.schedule
+			// The rule was active when last reconciled.
+			rule.Status.ScheduleState = readinessv1alpha1.ScheduleStateActive
+			fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(rule).Build()
+			recorder := events.NewFakeRecorder(10)
+			r := &RuleReconciler{
+				Client:     fc,
+				Controller: &RuleReadinessController{Client: fc, EventRecorder: recorder},
+			}
+
+			active, requeueAfter, err := r.reconcileSchedule(ctx, rule)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(active).To(Equal(tt.wantActive))
+			g.Expect(r.Controller.ruleScheduleActive(rule, time.Now())).To(Equal(tt.wantActive))
+			g.Expect(rule.Status.ScheduleState).To(Equal(tt.wantState))
+			g.Expect(rule.Status.NextTransitionTime.IsZero()).To(Equal(!tt.wantNext))
+			g.Expect(requeueAfter > 0).To(Equal(tt.wantNext))
+
+			if tt.wantEvent == "" {
+				g.Expect(recorder.Events).To(BeEmpty())
+			} else {
+				g.Expect(<-recorder.Events).To(ContainSubstring(tt.wantEvent))
+			}
+
+			err = fc.Get(ctx, client.ObjectKey{Name: rule.Name}, &readinessv1alpha1.NodeReadinessRule{})
+			g.Expect(apierrors.IsNotFound(err)).To(Equal(tt.wantEvent == "RuleExpired"))
+		})
+	}
+}
+
+func TestRuleSchedule_ParsedOncePerGeneration(t *testing.T) {
+	g := NewWithT(t)
+	c := &RuleReadinessController{}
+	rule := gpuRule()
+	rule.Generation = 1
+	rule.Spec.Schedule.ActivationWindows = []readinessv1alpha1.ActivationWindow{{Start: "0 2 * * *", DurationSeconds: 3600}}
+
+	first, err := c.ruleSchedule(rule)
+	g.Expect(err).NotTo(HaveOccurred())
+	again, err := c.ruleSchedule(rule)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(again).To(BeIdenticalTo(first))
+
+	// A spec change bumps the generation and is parsed again.
+	rule.Generation = 2
+	rule.Spec.Schedule.ActivationWindows[0].Start = "not a schedule"
+	_, err = c.ruleSchedule(rule)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(c.ruleScheduleActive(rule, time.Now())).To(BeFalse())
+}
@@ -0,0 +1,324 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/metrics"
+)
+
+// getOutOfScopeRulesForNode returns all cached rules that do not apply to a node.
+func (r *RuleReadinessController) getOutOfScopeRulesForNode(ctx context.Context, node *corev1.Node) []*readinessv1alpha1.NodeReadinessRule {
+	r.ruleCacheMutex.RLock()
+	defer r.ruleCacheMutex.RUnlock()
+
+	var outOfScopeRules []*readinessv1alpha1.NodeReadinessRule
+	for _, rule := range r.ruleCache {
+		if !r.ruleAppliesTo(ctx, rule, node) {
+			outOfScopeRules = append(outOfScopeRules, rule.DeepCopy())
+		}
+	}
+
+	return outOfScopeRules
+}
+
+// nodeInNodeScope reports whether the node falls within the rule's nodeScope.
+// The node's creation is compared with the rule's, so that the outcome does
+// not change as the node ages.
+func nodeInNodeScope(rule *readinessv1alpha1.NodeReadinessRule, node *corev1.Node) bool {
+	var maxAge time.Duration
+	switch rule.Spec.NodeScope.Type {
+	case readinessv1alpha1.NodeScopeTypeCreatedAfterRule:
+	case readinessv1alpha1.NodeScopeTypeMaxAge:
+		maxAge = time.Duration(rule.Spec.NodeScope.MaxAgeSeconds) * time.Second
+	default:
+		return true
+	}
+	return !node.CreationTimestamp.Before(&metav1.Time{Time: rule.CreationTimestamp.Add(-maxAge)})
+}
+
+// nodeInRuleStatus reports whether the rule's status has an entry for the node.
+func nodeInRuleStatus(rule *readinessv1alpha1.NodeReadinessRule, nodeName string) bool {
+	return slices.ContainsFunc(rule.Status.NodeEvaluations, func(eval readinessv1alpha1.NodeEvaluation) bool {
+		return eval.NodeName == nodeName
+	}) || slices.ContainsFunc(rule.Status.FailedNodes, func(failure readinessv1alpha1.NodeFailure) bool {
+		return failure.NodeName == nodeName
+	})
+}
+
+// taintManagedByOtherRule reports whether any of the rules manages the same
+// taint key and effect as rule.
+func taintManagedByOtherRule(rule *readinessv1alpha1.NodeReadinessRule, others []*readinessv1alpha1.NodeReadinessRule) bool {
+	effects := rule.Spec.GetTaintEffects()
+	for _, other := range others {
+		if other.Name == rule.Name || other.Spec.Taint.Key != rule.Spec.Taint.Key {
+			continue
+		}
+		for _, effect := range other.Spec.GetTaintEffects() {
+			if slices.Contains(effects, effect) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// trackRuleNode records that the rule selects the node.
+func (r *RuleReadinessController) trackRuleNode(ruleName, nodeName string) {
+	r.ruleNodesMutex.Lock()
+	defer r.ruleNodesMutex.Unlock()
+	if r.ruleNodes == nil {
+		r.ruleNodes = make(map[string]sets.Set[string])
+	}
+	if r.ruleNodes[ruleName] == nil {
+		r.ruleNodes[ruleName] = sets.New[string]()
+	}
+	r.ruleNodes[ruleName].Insert(nodeName)
+}
+
+// untrackRuleNode forgets that the rule selects the node.
+func (r *RuleReadinessController) untrackRuleNode(ruleName, nodeName string) {
+	r.ruleNodesMutex.Lock()
+	defer r.ruleNodesMutex.Unlock()
+	r.ruleNodes[ruleName].Delete(nodeName)
+}
+
+// ruleTracksNode reports whether the rule selected the node when it was last
+// evaluated, and so may have a taint or status entry to release.
+func (r *RuleReadinessController) ruleTracksNode(ruleName, nodeName string) bool {
+	r.ruleNodesMutex.Lock()
+	defer r.ruleNodesMutex.Unlock()
+	return r.ruleNodes[ruleName].Has(nodeName)
+}
+
+// setRuleNodes records the nodes the rule selects, replacing those recorded so far.
+func (r *RuleReadinessController) setRuleNodes(ruleName string, nodeNames sets.Set[string]) {
+	r.ruleNodesMutex.Lock()
+	defer r.ruleNodesMutex.Unlock()
+	if r.ruleNodes == nil {
+		r.ruleNodes = make(map[string]sets.Set[string])
+	}
+	r.ruleNodes[ruleName] = nodeNames
+}
+
+// deleteRuleNodes forgets the nodes a deleted rule selected.
+func (r *RuleReadinessController) deleteRuleNodes(ruleName string) {
+	r.ruleNodesMutex.Lock()
+	defer r.ruleNodesMutex.Unlock()
+	delete(r.ruleNodes, ruleName)
+}
+
+// releaseNodeFromOutOfScopeRules handles a node that no longer matches the
+// nodeSelector of rules that have evaluated it. Unless a rule opts out with
+// the KeepTaint node exit policy, the rule's taint is removed from the node and
+// the node is dropped from the rule's status, so neither is left behind stale.
+// Rules that did not select the node when they last evaluated it are skipped,
+// so that a node event does not cost work for every rule; the nodes rules
+// stop selecting while the controller is down are released by syncRuleScope.
+func (r *RuleReadinessController) releaseNodeFromOutOfScopeRules(ctx context.Context, node *corev1.Node) error {
+	var errs []error
+	for _, cached := range r.getOutOfScopeRulesForNode(ctx, node) {
+		if !r.ruleTracksNode(cached.Name, node.Name) {
+			continue
+		}
+
+		if err := r.updateNodeDryRun(ctx, cached, node, false); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update dry run results of rule %s: %w", cached.Name, err))
+			continue
+		}
+
+		if !cached.DeletionTimestamp.IsZero() {
+			r.untrackRuleNode(cached.Name, node.Name)
+			continue
+		}
+		if cached.Spec.GetNodeExitPolicy() == readinessv1alpha1.NodeExitPolicyKeepTaint {
+			if !cached.Spec.DryRun {
+				if err := r.retainRuleTaint(ctx, node, cached); err != nil {
+					errs = append(errs, fmt.Errorf("failed to retain taint of rule %s: %w", cached.Name, err))
+					continue
+				}
+			}
+			r.untrackRuleNode(cached.Name, node.Name)
+			continue
+		}
+
+		// The cached rule only tracks spec changes, so check the latest status.
+		rule := &readinessv1alpha1.NodeReadinessRule{}
+		if err := r.Get(ctx, client.ObjectKey{Name: cached.Name}, rule); err != nil {
+			if !apierrors.IsNotFound(err) {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if r.NodeStatusObjects {
+			if err := r.loadNodeStatus(ctx, rule, node.Name); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+		if nodeInRuleStatus(rule, node.Name) {
+			if err := r.releaseNodeFromRule(ctx, rule, node, "NodeLeftRuleScope",
+				fmt.Sprintf("Node no longer matches the node selector of rule '%s'", rule.Name)); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+		r.untrackRuleNode(rule.Name, node.Name)
+	}
+
+	return errors.Join(errs...)
+}
+
+// syncRuleScope records the nodes the rule selects, and releases the nodes
+// its status still has entries for but that it no longer selects, e.g. after
+// its nodeSelector was edited. The released nodes are dropped from the rule's
+// status in place as well.
+func (r *RuleReadinessController) syncRuleScope(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, nodeList *corev1.NodeList) error {
+	selected := sets.New[string]()
+	var errs []error
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if r.ruleAppliesTo(ctx, rule, node) {
+			selected.Insert(node.Name)
+			continue
+		}
+		if !nodeInRuleStatus(rule, node.Name) {
+			continue
+		}
+		if rule.Spec.GetNodeExitPolicy() == readinessv1alpha1.NodeExitPolicyKeepTaint {
+			if !rule.Spec.DryRun {
+				if err := r.retainRuleTaint(ctx, node, rule); err != nil {
+					errs = append(errs, fmt.Errorf("failed to retain taint of rule %s on node %s: %w", rule.Name, node.Name, err))
+				}
+			}
+			continue
+		}
+		if err := r.releaseNodeFromRule(ctx, rule, node, "NodeLeftRuleScope",
+			fmt.Sprintf("Node no longer matches the node selector of rule '%s'", rule.Name)); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		dropNodeFromRuleStatus(rule, node.Name)
+	}
+	r.setRuleNodes(rule.Name, selected)
+	return errors.Join(errs...)
+}
+
+// releaseNodeFromRule removes the rule's taint from a node the rule no longer
+// enforces, unless another rule selecting the node manages the same taint,
+// drops the node from the rule's status, and records an event with the given
+// reason and message.
+func (r *RuleReadinessController) releaseNodeFromRule(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule, node *corev1.Node, reason, message string) error {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Releasing node from rule", "node", node.Name, "rule", rule.Name, "reason", reason)
+
+	taintRemoved := false
+	if !rule.Spec.DryRun && r.hasRuleTaint(node, rule) {
+		if taintManagedByOtherRule(rule, r.getApplicableRulesForNode(ctx, node)) {
+			log.Info("Keeping taint managed by another rule matching the node",
+				"node", node.Name, "rule", rule.Name, "taint", rule.Spec.Taint.Key)
+		} else {
+			if err := r.removeRuleTaint(ctx, node, rule); err != nil {
+				metrics.Failures.WithLabelValues(rule.Name, string(metrics.FailureReasonRemoveTaintError)).Inc()
+				return fmt.Errorf("failed to remove taint of rule %s: %w", rule.Name, err)
+			}
+			metrics.TaintOperations.WithLabelValues(rule.Name, string(metrics.TaintOperationRemove)).Inc()
+			taintRemoved = true
+		}
+	}
+
+	patchedRule, err := r.removeNodeFromRuleStatus(ctx, rule.Name, node.Name)
+	if err != nil {
+		return fmt.Errorf("failed to remove node from status of rule %s: %w", rule.Name, err)
+	}
+	if r.EnableNodeStateMetrics && patchedRule != nil {
+		r.syncRuleNodeStateMetrics(ctx, patchedRule)
+	}
+
+	if taintRemoved {
+		message += fmt.Sprintf(", taint '%s' removed", rule.Spec.Taint.Key)
+	}
+	r.EventRecorder.Eventf(node, nil, corev1.EventTypeNormal, reason, "ReleaseNode", "%s", message)
+	return nil
+}
+
+// dropNodeFromRuleStatus drops the node's evaluation and failure entries from the rule's status.
+func dropNodeFromRuleStatus(rule *readinessv1alpha1.NodeReadinessRule, nodeName string) {
+	rule.Status.NodeEvaluations = slices.DeleteFunc(rule.Status.NodeEvaluations, func(eval readinessv1alpha1.NodeEvaluation) bool {
+		return eval.NodeName == nodeName
+	})
+	rule.Status.FailedNodes = slices.DeleteFunc(rule.Status.FailedNodes, func(failure readinessv1alpha1.NodeFailure) bool {
+		return failure.NodeName == nodeName
+	})
+	rule.Status.AppliedNodes = slices.DeleteFunc(rule.Status.AppliedNodes, func(name string) bool {
+		return name == nodeName
+	})
+}
+
+// removeNodeFromRuleStatus drops the node's evaluation and failure entries
+// from the rule's status. It returns the patched rule, or nil if there was
+// nothing to remove.
+func (r *RuleReadinessController) removeNodeFromRuleStatus(ctx context.Context, ruleName, nodeName string) (*readinessv1alpha1.NodeReadinessRule, error) {
+	var patched *readinessv1alpha1.NodeReadinessRule
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		patched = nil
+
+		fresh := &readinessv1alpha1.NodeReadinessRule{}
+		if err := r.Get(ctx, client.ObjectKey{Name: ruleName}, fresh); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		patch := client.MergeFrom(fresh.DeepCopy())
+		if r.NodeStatusObjects {
+			if err := r.loadNodeStatus(ctx, fresh, nodeName); err != nil {
+				return err
+			}
+		}
+		if !nodeInRuleStatus(fresh, nodeName) {
+			return nil
+		}
+
+		// The node is no longer counted, with the taint status it was last
+		// evaluated with.
+		removed := r.nodeCountOf(fresh, nil, ruleEvaluationFor(fresh, nodeName))
+		dropNodeFromRuleStatus(fresh, nodeName)
+		addRuleNodeCounts(fresh, nodeCount{}.sub(removed))
+		if err := r.Status().Patch(ctx, fresh, patch); err != nil {
+			return err
+		}
+
+		patched = fresh
+		return nil
+	})
+	if err == nil && patched != nil && r.NodeStatusObjects {
+		err = r.removeNodeStatus(ctx, ruleName, nodeName)
+	}
+	return patched, err
+}
@@ -0,0 +1,312 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+func TestReleaseNodeFromOutOfScopeRules(t *testing.T) {
+	// newRelabeledNode returns a tainted node that no longer carries the gpu label.
+	newRelabeledNode := func() *corev1.Node {
+		node := gpuNode("gpu-node", true)
+		node.Labels = map[string]string{"gpu": "false"}
+		return node
+	}
+	newEvaluatedRule := func() *readinessv1alpha1.NodeReadinessRule {
+		rule := gpuRule()
+		rule.Status.AppliedNodes = []string{"gpu-node", "other-node"}
+		rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{
+			{NodeName: "gpu-node", TaintStatus: readinessv1alpha1.TaintStatusPresent},
+			{NodeName: "other-node", TaintStatus: readinessv1alpha1.TaintStatusAbsent},
+		}
+		return rule
+	}
+
+	tests := []struct {
+		name       string
+		rules      []*readinessv1alpha1.NodeReadinessRule
+		wantTaint  bool
+		wantStatus bool
+		wantEvent  string
+	}{
+		{
+			name:      "taint and status entry are removed",
+			rules:     []*readinessv1alpha1.NodeReadinessRule{newEvaluatedRule()},
+			wantEvent: "taint 'readiness.k8s.io/gpu-ready' removed",
+		},
+		{
+			name: "KeepTaint leaves the taint and status entry in place",
+			rules: func() []*readinessv1alpha1.NodeReadinessRule {
+				rule := newEvaluatedRule()
+				rule.Spec.NodeExitPolicy = readinessv1alpha1.NodeExitPolicyKeepTaint
+				return []*readinessv1alpha1.NodeReadinessRule{rule}
+			}(),
+			wantTaint:  true,
+			wantStatus: true,
+		},
+		{
+			name: "taint managed by another matching rule is kept",
+			rules: func() []*readinessv1alpha1.NodeReadinessRule {
+				other := gpuRule()
+				other.Name = "gpu-ready-fallback"
+				other.Spec.NodeSelector.MatchLabels = map[string]string{"gpu": "false"}
+				return []*readinessv1alpha1.NodeReadinessRule{newEvaluatedRule(), other}
+			}(),
+			wantTaint: true,
+			wantEvent: "no longer matches the node selector of rule 'gpu-ready'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			node := newRelabeledNode()
+
+			builder := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node)
+			for _, rule := range tt.rules {
+				builder = builder.WithObjects(rule).WithStatusSubresource(rule)
+			}
+			fc := builder.Build()
+			recorder := events.NewFakeRecorder(10)
+			c := &RuleReadinessController{
+				Client:        fc,
+				EventRecorder: recorder,
+				ruleCache:     make(map[string]*readinessv1alpha1.NodeReadinessRule),
+			}
+			for _, rule := range tt.rules {
+				c.updateRuleCache(ctx, rule)
+			}
+			c.trackRuleNode("gpu-ready", node.Name)
+
+			g.Expect(c.releaseNodeFromOutOfScopeRules(ctx, node)).To(Succeed())
+
+			updatedNode := &corev1.Node{}
+			g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updatedNode)).To(Succeed())
+			g.Expect(c.hasTaintBySpec(updatedNode, gpuTaint())).To(Equal(tt.wantTaint))
+
+			updatedRule := &readinessv1alpha1.NodeReadinessRule{}
+			g.Expect(fc.Get(ctx, client.ObjectKey{Name: "gpu-ready"}, updatedRule)).To(Succeed())
+			g.Expect(nodeInRuleStatus(updatedRule, node.Name)).To(Equal(tt.wantStatus))
+			g.Expect(nodeInRuleStatus(updatedRule, "other-node")).To(BeTrue())
+			if !tt.wantStatus {
+				g.Expect(updatedRule.Status.AppliedNodes).To(ConsistOf("other-node"))
+			}
+
+			var received []string
+			for len(recorder.Events) > 0 {
+				received = append(received, <-recorder.Events)
+			}
+			if tt.wantEvent != "" {
+				g.Expect(received).To(ContainElement(ContainSubstring(tt.wantEvent)))
+			} else {
+				g.Expect(received).To(BeEmpty())
+			}
+		})
+	}
+
+	t.Run("nodes the rule never evaluated are left alone", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := context.Background()
+		node := newRelabeledNode()
+		rule := gpuRule()
+		fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).
+			WithObjects(node, rule).WithStatusSubresource(rule).Build()
+		recorder := events.NewFakeRecorder(10)
+		c := &RuleReadinessController{
+			Client:        fc,
+			EventRecorder: recorder,
+			ruleCache:     map[string]*readinessv1alpha1.NodeReadinessRule{rule.Name: rule},
+		}
+		c.trackRuleNode(rule.Name, node.Name)
+
+		g.Expect(c.releaseNodeFromOutOfScopeRules(ctx, node)).To(Succeed())
+
+		updatedNode := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updatedNode)).To(Succeed())
+		g.Expect(c.hasTaintBySpec(updatedNode, gpuTaint())).To(BeTrue())
+		g.Expect(recorder.Events).NotTo(Receive())
+		g.Expect(c.ruleTracksNode(rule.Name, node.Name)).To(BeFalse())
+	})
+
+	t.Run("rules that did not select the node are skipped without reading them", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := context.Background()
+		node := newRelabeledNode()
+		rule := newEvaluatedRule()
+		var gets int
+		fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).
+			WithObjects(node, rule).WithStatusSubresource(rule).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if _, ok := obj.(*readinessv1alpha1.NodeReadinessRule); ok {
+						gets++
+					}
+					return c.Get(ctx, key, obj, opts...)
+				},
+			}).Build()
+		c := &RuleReadinessController{
+			Client:        fc,
+			EventRecorder: events.NewFakeRecorder(10),
+			ruleCache:     map[string]*readinessv1alpha1.NodeReadinessRule{rule.Name: rule},
+		}
+
+		g.Expect(c.releaseNodeFromOutOfScopeRules(ctx, node)).To(Succeed())
+
+		g.Expect(gets).To(BeZero())
+		updatedNode := &corev1.Node{}
+		g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updatedNode)).To(Succeed())
+		g.Expect(c.hasTaintBySpec(updatedNode, gpuTaint())).To(BeTrue())
+	})
+}
+
+func TestSyncRuleScope(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	// The rule's nodeSelector was edited so that it no longer selects gpu-node.
+	rule := gpuRule()
+	rule.Spec.NodeSelector.MatchLabels = map[string]string{"gpu": "a100"}
+	rule.Status.AppliedNodes = []string{"gpu-node", "a100-node"}
+	rule.Status.NodeEvaluations = []readinessv1alpha1.NodeEvaluation{
+		{NodeName: "gpu-node", TaintStatus: readinessv1alpha1.TaintStatusPresent},
+		{NodeName: "a100-node", TaintStatus: readinessv1alpha1.TaintStatusPresent},
+	}
+	node := gpuNode("gpu-node", true)
+	selectedNode := gpuNode("a100-node", true)
+	selectedNode.Labels = map[string]string{"gpu": "a100"}
+
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).
+		WithObjects(node, selectedNode, rule).WithStatusSubresource(rule).Build()
+	recorder := events.NewFakeRecorder(10)
+	c := &RuleReadinessController{
+		Client:        fc,
+		EventRecorder: recorder,
+		ruleCache:     map[string]*readinessv1alpha1.NodeReadinessRule{rule.Name: rule},
+	}
+	c.trackRuleNode(rule.Name, node.Name)
+
+	nodeList := &corev1.NodeList{Items: []corev1.Node{*node, *selectedNode}}
+	g.Expect(c.syncRuleScope(ctx, rule, nodeList)).To(Succeed())
+
+	updatedNode := &corev1.Node{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updatedNode)).To(Succeed())
+	g.Expect(c.hasTaintBySpec(updatedNode, gpuTaint())).To(BeFalse())
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: selectedNode.Name}, updatedNode)).To(Succeed())
+	g.Expect(c.hasTaintBySpec(updatedNode, gpuTaint())).To(BeTrue())
+
+	// The node is dropped from both the stored and the in-memory status, so
+	// the rule reconciler does not write it back.
+	updatedRule := &readinessv1alpha1.NodeReadinessRule{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: rule.Name}, updatedRule)).To(Succeed())
+	g.Expect(nodeInRuleStatus(updatedRule, node.Name)).To(BeFalse())
+	g.Expect(nodeInRuleStatus(rule, node.Name)).To(BeFalse())
+	g.Expect(rule.Status.AppliedNodes).To(ConsistOf("a100-node"))
+
+	g.Expect(c.ruleTracksNode(rule.Name, node.Name)).To(BeFalse())
+	g.Expect(c.ruleTracksNode(rule.Name, selectedNode.Name)).To(BeTrue())
+	var received []string
+	for len(recorder.Events) > 0 {
+		received = append(received, <-recorder.Events)
+	}
+	g.Expect(received).To(ContainElement(ContainSubstring("NodeLeftRuleScope")))
+}
+
+// scopedRule returns the gpu rule in bootstrap-only mode, created at the given
+// time with the given node scope.
+func scopedRule(created time.Time, scope readinessv1alpha1.NodeScope) *readinessv1alpha1.NodeReadinessRule {
+	rule := gpuRule()
+	rule.UID = "gpu-uid"
+	rule.CreationTimestamp = metav1.NewTime(created)
+	rule.Spec.EnforcementMode = readinessv1alpha1.EnforcementModeBootstrapOnly
+	rule.Spec.Conditions = []readinessv1alpha1.ConditionRequirement{
+		{Type: "GPUReady", RequiredStatus: corev1.ConditionTrue},
+	}
+	rule.Spec.NodeScope = scope
+	return rule
+}
+
+func TestNodeInNodeScope(t *testing.T) {
+	ruleCreated := time.Now()
+	maxAge := readinessv1alpha1.NodeScope{Type: readinessv1alpha1.NodeScopeTypeMaxAge, MaxAgeSeconds: 3600}
+	createdAfterRule := readinessv1alpha1.NodeScope{Type: readinessv1alpha1.NodeScopeTypeCreatedAfterRule}
+
+	tests := []struct {
+		name        string
+		scope       readinessv1alpha1.NodeScope
+		nodeCreated time.Time
+		want        bool
+	}{
+		{name: "no scope", nodeCreated: ruleCreated.Add(-24 * time.Hour), want: true},
+		{name: "created after the rule", scope: createdAfterRule, nodeCreated: ruleCreated.Add(time.Minute), want: true},
+		{name: "created before the rule", scope: createdAfterRule, nodeCreated: ruleCreated.Add(-time.Minute), want: false},
+		{name: "younger than maxAge", scope: maxAge, nodeCreated: ruleCreated.Add(-30 * time.Minute), want: true},
+		{name: "older than maxAge", scope: maxAge, nodeCreated: ruleCreated.Add(-2 * time.Hour), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			node := gpuNode("gpu-node", false)
+			node.CreationTimestamp = metav1.NewTime(tt.nodeCreated)
+			g.Expect(nodeInNodeScope(scopedRule(ruleCreated, tt.scope), node)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestEvaluateRuleForNode_OutOfNodeScope(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	rule := scopedRule(time.Now(), readinessv1alpha1.NodeScope{Type: readinessv1alpha1.NodeScopeTypeCreatedAfterRule})
+	oldNode := gpuNode("old-node", false)
+	oldNode.CreationTimestamp = metav1.NewTime(time.Now().Add(-24 * time.Hour))
+	newNode := gpuNode("new-node", false)
+	newNode.CreationTimestamp = metav1.NewTime(time.Now().Add(time.Minute))
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(oldNode, newNode).Build()
+	c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+
+	g.Expect(c.evaluateRuleForNode(ctx, rule, oldNode)).To(Succeed())
+	g.Expect(c.evaluateRuleForNode(ctx, rule, newNode)).To(Succeed())
+
+	updated := &corev1.Node{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: oldNode.Name}, updated)).To(Succeed())
+	g.Expect(updated.Spec.Taints).To(BeEmpty())
+	g.Expect(updated.Annotations).To(HaveKey(bootstrapAnnotationKey(rule.UID)))
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: newNode.Name}, updated)).To(Succeed())
+	g.Expect(updated.Spec.Taints).To(ConsistOf(HaveField("Key", gpuTaint().Key)))
+	g.Expect(updated.Annotations).NotTo(HaveKey(bootstrapAnnotationKey(rule.UID)))
+
+	rule.Spec.DryRun = true
+	nodes := &corev1.NodeList{Items: []corev1.Node{*oldNode, *newNode}}
+	g.Expect(c.processDryRun(ctx, rule, nodes)).To(Succeed())
+	g.Expect(*rule.Status.DryRunResults.AffectedNodes).To(BeEquivalentTo(1))
+	g.Expect(*rule.Status.DryRunResults.OutOfScopeNodes).To(BeEquivalentTo(1))
+	g.Expect(rule.Status.DryRunResults.Summary).To(ContainSubstring("1 nodes are outside the node scope"))
+}
@@ -0,0 +1,217 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+const (
+	// defaultSimulationTTL is how long finished simulations are kept when
+	// they do not set ttlSecondsAfterFinished.
+	defaultSimulationTTL = time.Hour
+
+	// maxSimulatedNodes is the number of nodes listed in a simulation's
+	// status, matching the MaxItems of its nodes.
+	maxSimulatedNodes = 1000
+)
+
+// SimulationReconciler evaluates each NodeReadinessRuleSimulation once, the
+// way a rule in dry run mode is evaluated, and deletes it once its TTL has
+// expired.
+type SimulationReconciler struct {
+	client.Client
+	Controller *RuleReadinessController
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SimulationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("nodereadinessrulesimulation").
+		For(&readinessv1alpha1.NodeReadinessRuleSimulation{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=readiness.node.x-k8s.io,resources=nodereadinessrulesimulations,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=readiness.node.x-k8s.io,resources=nodereadinessrulesimulations/status,verbs=get;update;patch
+
+func (r *SimulationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	simulation := &readinessv1alpha1.NodeReadinessRuleSimulation{}
+	if err := r.Get(ctx, req.NamespacedName, simulation); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !simulation.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	if simulation.Status.CompletionTime.IsZero() {
+		if err := r.simulate(ctx, simulation); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.Status().Update(ctx, simulation); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update simulation status: %w", err)
+		}
+		log.Info("Simulated rule", "simulation", simulation.Name, "summary", simulation.Status.Results.Summary)
+	}
+
+	ttl := defaultSimulationTTL
+	if simulation.Spec.TTLSecondsAfterFinished != nil {
+		ttl = time.Duration(*simulation.Spec.TTLSecondsAfterFinished) * time.Second
+	}
+	if remaining := time.Until(simulation.Status.CompletionTime.Add(ttl)); remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	log.Info("Deleting expired simulation", "simulation", simulation.Name)
+	return ctrl.Result{}, client.IgnoreNotFound(r.Delete(ctx, simulation,
+		client.Preconditions{UID: &simulation.UID}))
+}
+
+// simulatedCondition is a condition override with its parsed node selector.
+type simulatedCondition struct {
+	selector  labels.Selector
+	condition readinessv1alpha1.SimulatedCondition
+}
+
+// simulate evaluates the simulated rule against the current Nodes, with the
+// simulation's condition overrides applied, and records the outcome in the
+// simulation's status.
+func (r *SimulationReconciler) simulate(ctx context.Context, simulation *readinessv1alpha1.NodeReadinessRuleSimulation) error {
+	now := metav1.Now()
+	simulation.Status.CompletionTime = now
+
+	// The simulated rule is created along with the simulation, for its nodeScope.
+	rule := &readinessv1alpha1.NodeReadinessRule{
+		ObjectMeta: metav1.ObjectMeta{Name: simulation.Name, CreationTimestamp: simulation.CreationTimestamp},
+		Spec:       *simulation.Spec.Rule.DeepCopy(),
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&rule.Spec.NodeSelector)
+	if err != nil {
+		setSimulationFailed(simulation, fmt.Sprintf("Invalid nodeSelector: %v", err))
+		return nil
+	}
+	overrides := make([]simulatedCondition, 0, len(simulation.Spec.ConditionOverrides))
+	for i, override := range simulation.Spec.ConditionOverrides {
+		overrideSelector, err := metav1.LabelSelectorAsSelector(&override.NodeSelector)
+		if err != nil {
+			setSimulationFailed(simulation, fmt.Sprintf("Invalid nodeSelector of conditionOverrides[%d]: %v", i, err))
+			return nil
+		}
+		overrides = append(overrides, simulatedCondition{selector: overrideSelector, condition: override})
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := r.List(ctx, nodeList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	plan := make(map[string]nodeDryRun, len(nodeList.Items))
+	var nodes []readinessv1alpha1.SimulatedNode
+	for i := range nodeList.Items {
+		node := nodeList.Items[i].DeepCopy()
+		applySimulatedConditions(node, overrides)
+
+		outcome := r.Controller.planNodeDryRun(rule, node, now.Time)
+		plan[node.Name] = outcome
+		if !outcome.outOfScope {
+			nodes = append(nodes, simulatedNode(node, rule, outcome))
+		}
+	}
+	slices.SortFunc(nodes, func(a, b readinessv1alpha1.SimulatedNode) int {
+		return cmp.Compare(a.NodeName, b.NodeName)
+	})
+	if len(nodes) > maxSimulatedNodes {
+		nodes = nodes[:maxSimulatedNodes]
+	}
+
+	simulation.Status.Results = dryRunResults(plan)
+	simulation.Status.Nodes = nodes
+	meta.RemoveStatusCondition(&simulation.Status.Conditions, readinessv1alpha1.SimulationConditionFailed)
+	meta.SetStatusCondition(&simulation.Status.Conditions, metav1.Condition{
+		Type:               readinessv1alpha1.SimulationConditionComplete,
+		Status:             metav1.ConditionTrue,
+		Reason:             readinessv1alpha1.SimulationReasonEvaluated,
+		Message:            fmt.Sprintf("Evaluated %d nodes", len(plan)),
+		ObservedGeneration: simulation.Generation,
+	})
+	return nil
+}
+
+// setSimulationFailed finishes the simulation without evaluating the rule.
+func setSimulationFailed(simulation *readinessv1alpha1.NodeReadinessRuleSimulation, message string) {
+	meta.SetStatusCondition(&simulation.Status.Conditions, metav1.Condition{
+		Type:               readinessv1alpha1.SimulationConditionFailed,
+		Status:             metav1.ConditionTrue,
+		Reason:             readinessv1alpha1.SimulationReasonInvalidNodeSelector,
+		Message:            message,
+		ObservedGeneration: simulation.Generation,
+	})
+}
+
+// applySimulatedConditions sets the overridden conditions on the node, adding
+// those it does not report.
+func applySimulatedConditions(node *corev1.Node, overrides []simulatedCondition) {
+	for _, override := range overrides {
+		if !override.selector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		conditionType := corev1.NodeConditionType(override.condition.Type)
+		i := slices.IndexFunc(node.Status.Conditions, func(condition corev1.NodeCondition) bool {
+			return condition.Type == conditionType
+		})
+		if i < 0 {
+			node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{Type: conditionType})
+			i = len(node.Status.Conditions) - 1
+		}
+		node.Status.Conditions[i].Status = override.condition.Status
+	}
+}
+
+// simulatedNode reports the outcome of the simulated rule for the node.
+func simulatedNode(node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule, outcome nodeDryRun) readinessv1alpha1.SimulatedNode {
+	hasTaint := findRuleTaint(node, rule) != nil
+	switch outcome.change {
+	case readinessv1alpha1.DryRunActionAddTaint:
+		hasTaint = true
+	case readinessv1alpha1.DryRunActionRemoveTaint:
+		hasTaint = false
+	}
+	return readinessv1alpha1.SimulatedNode{
+		NodeName:          node.Name,
+		TaintStatus:       taintStatusOf(hasTaint),
+		Action:            outcome.change,
+		FailingConditions: outcome.failing,
+		MissingConditions: outcome.missing,
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+func newSimulationReconciler(t *testing.T, objs ...client.Object) *SimulationReconciler {
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(objs...).
+		WithStatusSubresource(&readinessv1alpha1.NodeReadinessRuleSimulation{}).Build()
+	return &SimulationReconciler{Client: fc, Controller: &RuleReadinessController{Client: fc}}
+}
+
+func gpuSimulation() *readinessv1alpha1.NodeReadinessRuleSimulation {
+	return &readinessv1alpha1.NodeReadinessRuleSimulation{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu-what-if", UID: types.UID("sim-uid")},
+		Spec:       readinessv1alpha1.NodeReadinessRuleSimulationSpec{Rule: dryRunRule().Spec},
+	}
+}
+
+func TestSimulationReconciler(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	simulation := gpuSimulation()
+	simulation.Spec.ConditionOverrides = []readinessv1alpha1.SimulatedCondition{{
+		Type:         "gpu-ready",
+		Status:       corev1.ConditionTrue,
+		NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "a"}},
+	}}
+	overridden := gpuReadyNode("node-overridden", true, "")
+	overridden.Labels["pool"] = "a"
+	r := newSimulationReconciler(t, simulation, overridden,
+		gpuReadyNode("node-add", false, corev1.ConditionFalse),
+		gpuReadyNode("node-ready", false, corev1.ConditionTrue),
+	)
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: simulation.Name}}
+	result, err := r.Reconcile(ctx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(BeNumerically("~", defaultSimulationTTL, time.Minute))
+
+	latest := &readinessv1alpha1.NodeReadinessRuleSimulation{}
+	g.Expect(r.Get(ctx, req.NamespacedName, latest)).To(Succeed())
+	g.Expect(meta.IsStatusConditionTrue(latest.Status.Conditions, readinessv1alpha1.SimulationConditionComplete)).To(BeTrue())
+	g.Expect(latest.Status.CompletionTime.IsZero()).To(BeFalse())
+	g.Expect(latest.Status.Results.AffectedNodes).To(Equal(ptr.To[int32](3)))
+	g.Expect(latest.Status.Results.TaintsToAdd).To(Equal(ptr.To[int32](1)))
+	g.Expect(latest.Status.Results.TaintsToRemove).To(Equal(ptr.To[int32](1)))
+	g.Expect(latest.Status.Nodes).To(Equal([]readinessv1alpha1.SimulatedNode{
+		{
+			NodeName: "node-add", TaintStatus: readinessv1alpha1.TaintStatusPresent,
+			Action: readinessv1alpha1.DryRunActionAddTaint, FailingConditions: []string{"gpu-ready"},
+		},
+		{
+			NodeName: "node-overridden", TaintStatus: readinessv1alpha1.TaintStatusAbsent,
+			Action: readinessv1alpha1.DryRunActionRemoveTaint,
+		},
+		{NodeName: "node-ready", TaintStatus: readinessv1alpha1.TaintStatusAbsent},
+	}))
+
+	// The nodes are left untouched.
+	node := &corev1.Node{}
+	g.Expect(r.Get(ctx, client.ObjectKey{Name: "node-add"}, node)).To(Succeed())
+	g.Expect(node.Spec.Taints).To(BeEmpty())
+
+	// Finished simulations are not evaluated again, and are deleted once expired.
+	latest.Status.CompletionTime = metav1.NewTime(time.Now().Add(-defaultSimulationTTL))
+	latest.Status.Nodes = nil
+	g.Expect(r.Status().Update(ctx, latest)).To(Succeed())
+	_, err = r.Reconcile(ctx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	err = r.Get(ctx, req.NamespacedName, latest)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestSimulationReconciler_InvalidNodeSelector(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	simulation := gpuSimulation()
+	simulation.Spec.TTLSecondsAfterFinished = ptr.To[int32](60)
+	simulation.Spec.Rule.NodeSelector = metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+		{Key: "gpu", Operator: "Bogus"},
+	}}
+	r := newSimulationReconciler(t, simulation)
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: simulation.Name}}
+	result, err := r.Reconcile(ctx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(BeNumerically("~", time.Minute, time.Second))
+
+	latest := &readinessv1alpha1.NodeReadinessRuleSimulation{}
+	g.Expect(r.Get(ctx, req.NamespacedName, latest)).To(Succeed())
+	failed := meta.FindStatusCondition(latest.Status.Conditions, readinessv1alpha1.SimulationConditionFailed)
+	g.Expect(failed).NotTo(BeNil())
+	g.Expect(failed.Reason).To(Equal(readinessv1alpha1.SimulationReasonInvalidNodeSelector))
+	g.Expect(latest.Status.Nodes).To(BeEmpty())
+}
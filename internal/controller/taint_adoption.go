@@ -0,0 +1,110 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/metrics"
+)
+
+// applyTaintAdoptionPolicy handles a Node that carried the rule's taint before
+// the rule first evaluated it, according to the rule's taint adoption policy.
+// A refused Node stays refused for as long as it carries the taint. It returns
+// the resulting adoption, or "" when the Node is not concerned.
+func (r *RuleReadinessController) applyTaintAdoptionPolicy(ctx context.Context, node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule,
+	previousEvaluation *readinessv1alpha1.NodeEvaluation, shouldRemoveTaint bool) (readinessv1alpha1.TaintAdoption, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if previousEvaluation != nil {
+		if previousEvaluation.TaintAdoption != readinessv1alpha1.TaintAdoptionRefused {
+			return previousEvaluation.TaintAdoption, nil
+		}
+		if !r.hasRuleTaint(node, rule) {
+			log.Info("Pre-existing taint was removed, managing node", "node", node.Name, "rule", rule.Name)
+			return "", nil
+		}
+	} else if !r.hasRuleTaint(node, rule) {
+		return "", nil
+	}
+
+	switch rule.Spec.GetTaintAdoptionPolicy() {
+	case readinessv1alpha1.TaintAdoptionPolicyRefuse:
+		if previousEvaluation == nil {
+			log.Info("Refusing to adopt pre-existing taint", "node", node.Name, "rule", rule.Name, "taint", rule.Spec.Taint.Key)
+			metrics.Failures.WithLabelValues(rule.Name, string(metrics.FailureReasonTaintAdoptionRefused)).Inc()
+			r.EventRecorder.Eventf(node, nil, corev1.EventTypeWarning, "TaintAdoptionRefused", "AdoptTaint",
+				"Node already carries taint '%s:%s', rule '%s' will not manage the node until it is removed",
+				rule.Spec.Taint.Key, rule.Spec.Taint.Effect, rule.Name)
+		}
+		return readinessv1alpha1.TaintAdoptionRefused, nil
+
+	case readinessv1alpha1.TaintAdoptionPolicyReplace:
+		if previousEvaluation == nil {
+			log.Info("Replacing pre-existing taint", "node", node.Name, "rule", rule.Name, "taint", rule.Spec.Taint.Key)
+			if err := r.replaceRuleTaint(ctx, node, rule, !shouldRemoveTaint); err != nil {
+				return "", err
+			}
+			r.EventRecorder.Eventf(node, nil, corev1.EventTypeNormal, "TaintReplaced", "ReplaceTaint",
+				"Pre-existing taint '%s:%s' replaced by rule '%s'", rule.Spec.Taint.Key, rule.Spec.Taint.Effect, rule.Name)
+		}
+		return readinessv1alpha1.TaintAdoptionReplaced, nil
+
+	default:
+		// A refused Node is adopted once the policy no longer refuses it.
+		return readinessv1alpha1.TaintAdoptionAdopted, nil
+	}
+}
+
+// replaceRuleTaint removes the rule's taint from the node, whatever its value
+// and effect, and applies the rule's taint afresh in the same patch when
+// reapply is set.
+func (r *RuleReadinessController) replaceRuleTaint(ctx context.Context, node *corev1.Node, rule *readinessv1alpha1.NodeReadinessRule, reapply bool) error {
+	effects := rule.Spec.GetTaintEffects()
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestNode := &corev1.Node{}
+		if err := r.Get(ctx, client.ObjectKey{Name: node.Name}, latestNode); err != nil {
+			return err
+		}
+
+		stored := latestNode.DeepCopy()
+		latestNode.Spec.Taints = slices.DeleteFunc(latestNode.Spec.Taints, func(taint corev1.Taint) bool {
+			return taint.Key == rule.Spec.Taint.Key && slices.Contains(effects, taint.Effect)
+		})
+		if reapply {
+			taint := rule.Spec.Taint
+			taint.TimeAdded = &metav1.Time{Time: time.Now()}
+			latestNode.Spec.Taints = append(latestNode.Spec.Taints, taint)
+		}
+		if err := r.Patch(ctx, latestNode, client.MergeFromWithOptions(stored, client.MergeFromWithOptimisticLock{})); err != nil {
+			return err
+		}
+
+		// Update the original node reference with the latest state
+		*node = *latestNode
+		return nil
+	})
+}
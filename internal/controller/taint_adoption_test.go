@@ -0,0 +1,137 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// adoptingRule returns the gpu rule with the given taint adoption policy and
+// a condition the test nodes do not satisfy.
+func adoptingRule(policy readinessv1alpha1.TaintAdoptionPolicy) *readinessv1alpha1.NodeReadinessRule {
+	rule := gpuRule()
+	rule.Spec.Conditions = []readinessv1alpha1.ConditionRequirement{
+		{Type: "GPUReady", RequiredStatus: corev1.ConditionTrue},
+	}
+	rule.Spec.TaintAdoptionPolicy = policy
+	return rule
+}
+
+// foreignTaintedNode returns a gpu node carrying the rule's taint, applied
+// with another value an hour ago by someone else.
+func foreignTaintedNode() *corev1.Node {
+	node := gpuNode("gpu-node", false)
+	taint := gpuTaint()
+	taint.Value = "provisioning"
+	taint.TimeAdded = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+	node.Spec.Taints = []corev1.Taint{taint}
+	return node
+}
+
+func TestTaintAdoptionPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       readinessv1alpha1.TaintAdoptionPolicy
+		wantValue    string
+		wantFresh    bool
+		wantAdoption readinessv1alpha1.TaintAdoption
+		wantEvent    string
+	}{
+		{
+			name:         "Adopt keeps the existing taint",
+			policy:       "",
+			wantValue:    "provisioning",
+			wantAdoption: readinessv1alpha1.TaintAdoptionAdopted,
+			wantEvent:    "TaintAdopted",
+		},
+		{
+			name:         "Refuse leaves the node untouched",
+			policy:       readinessv1alpha1.TaintAdoptionPolicyRefuse,
+			wantValue:    "provisioning",
+			wantAdoption: readinessv1alpha1.TaintAdoptionRefused,
+			wantEvent:    "TaintAdoptionRefused",
+		},
+		{
+			name:         "Replace applies the rule's taint afresh",
+			policy:       readinessv1alpha1.TaintAdoptionPolicyReplace,
+			wantValue:    "",
+			wantFresh:    true,
+			wantAdoption: readinessv1alpha1.TaintAdoptionReplaced,
+			wantEvent:    "TaintReplaced",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			rule := adoptingRule(tt.policy)
+			node := foreignTaintedNode()
+			fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+			recorder := events.NewFakeRecorder(10)
+			c := &RuleReadinessController{Client: fc, EventRecorder: recorder}
+
+			g.Expect(c.evaluateRuleForNode(ctx, rule, node)).To(Succeed())
+
+			updated := &corev1.Node{}
+			g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+			g.Expect(updated.Spec.Taints).To(HaveLen(1))
+			g.Expect(updated.Spec.Taints[0].Value).To(Equal(tt.wantValue))
+			g.Expect(updated.Spec.Taints[0].TimeAdded.After(time.Now().Add(-time.Minute))).To(Equal(tt.wantFresh))
+			g.Expect(c.getPreviousNodeEvaluation(rule, node.Name).TaintAdoption).To(Equal(tt.wantAdoption))
+			g.Expect(<-recorder.Events).To(ContainSubstring(tt.wantEvent))
+
+			// The outcome is only reported once.
+			g.Expect(c.evaluateRuleForNode(ctx, rule, updated)).To(Succeed())
+			g.Expect(c.getPreviousNodeEvaluation(rule, node.Name).TaintAdoption).To(Equal(tt.wantAdoption))
+			g.Expect(recorder.Events).To(BeEmpty())
+		})
+	}
+}
+
+func TestTaintAdoptionPolicy_RefusedTaintRemoved(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	rule := adoptingRule(readinessv1alpha1.TaintAdoptionPolicyRefuse)
+	node := foreignTaintedNode()
+	fc := fakeclient.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(node).Build()
+	c := &RuleReadinessController{Client: fc, EventRecorder: events.NewFakeRecorder(10)}
+
+	g.Expect(c.evaluateRuleForNode(ctx, rule, node)).To(Succeed())
+	g.Expect(c.getPreviousNodeEvaluation(rule, node.Name).TaintAdoption).To(Equal(readinessv1alpha1.TaintAdoptionRefused))
+
+	// Once the operator removes the pre-existing taint, the rule manages the node.
+	node.Spec.Taints = nil
+	g.Expect(fc.Update(ctx, node)).To(Succeed())
+	g.Expect(c.evaluateRuleForNode(ctx, rule, node)).To(Succeed())
+
+	updated := &corev1.Node{}
+	g.Expect(fc.Get(ctx, client.ObjectKey{Name: node.Name}, updated)).To(Succeed())
+	g.Expect(updated.Spec.Taints).To(ConsistOf(HaveField("Key", gpuTaint().Key)))
+	g.Expect(c.getPreviousNodeEvaluation(rule, node.Name).TaintAdoption).To(BeEmpty())
+}
@@ -27,17 +27,21 @@ import (
 type FailureReason string
 
 const (
-	FailureReasonEvaluationError  FailureReason = "EvaluationError"
-	FailureReasonAddTaintError    FailureReason = "AddTaintError"
-	FailureReasonRemoveTaintError FailureReason = "RemoveTaintError"
+	FailureReasonEvaluationError      FailureReason = "EvaluationError"
+	FailureReasonAddTaintError        FailureReason = "AddTaintError"
+	FailureReasonRemoveTaintError     FailureReason = "RemoveTaintError"
+	FailureReasonEscalateTaintError   FailureReason = "EscalateTaintError"
+	FailureReasonDrainError           FailureReason = "DrainError"
+	FailureReasonTaintAdoptionRefused FailureReason = "TaintAdoptionRefused"
 )
 
 // TaintOperation represents a taint operation.
 type TaintOperation string
 
 const (
-	TaintOperationRemove TaintOperation = "remove"
-	TaintOperationAdd    TaintOperation = "add"
+	TaintOperationRemove   TaintOperation = "remove"
+	TaintOperationAdd      TaintOperation = "add"
+	TaintOperationEscalate TaintOperation = "escalate"
 )
 
 // ReconciliationOperation represents a reconciliation operation.
@@ -55,6 +59,7 @@ const (
 	NodeStateReady         NodeState = "ready"
 	NodeStateNotReady      NodeState = "not_ready"
 	NodeStateBootstrapping NodeState = "bootstrapping"
+	NodeStateQuarantined   NodeState = "quarantined"
 )
 
 // RuleNodeState represents whether a node is held or released by a rule.
@@ -74,7 +79,7 @@ var (
 		},
 	)
 
-	// TaintOperations tracks the number of taint operations (add/remove).
+	// TaintOperations tracks the number of taint operations (add/remove/escalate).
 	TaintOperations = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "node_readiness_taint_operations_total",
@@ -102,6 +107,16 @@ var (
 		[]string{"rule", "reason"},
 	)
 
+	// NodeTransitions tracks the transitions recorded in the per-node
+	// transition history of rules.
+	NodeTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "node_readiness_node_transitions_total",
+			Help: "Total number of node transitions recorded per rule and transition type",
+		},
+		[]string{"rule", "type"},
+	)
+
 	// BootstrapCompleted tracks the number of nodes that have completed bootstrap.
 	BootstrapCompleted = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -122,6 +137,15 @@ var (
 		[]string{"rule"},
 	)
 
+	// BootstrapRearmed tracks the number of times bootstrap was re-armed on a node, by trigger.
+	BootstrapRearmed = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "node_readiness_bootstrap_rearmed_total",
+			Help: "Total number of times bootstrap was re-armed on a node",
+		},
+		[]string{"rule", "trigger"},
+	)
+
 	// ReconciliationLatency tracks end-to-end latency from condition change to taint operation.
 	// This measures how quickly the controller responds to node condition changes.
 	ReconciliationLatency = prometheus.NewHistogramVec(
@@ -140,7 +164,7 @@ var (
 			Name: "node_readiness_nodes_by_state",
 			Help: "Number of nodes in each readiness state per rule",
 		},
-		[]string{"rule", "state"}, // state: ready, not_ready, bootstrapping
+		[]string{"rule", "state"}, // state: ready, not_ready, bootstrapping, quarantined
 	)
 
 	// ConditionEvaluationFailures tracks which specific checks within a rule are failing.
@@ -153,6 +177,52 @@ var (
 		[]string{"rule", "condition"},
 	)
 
+	// PodsEvicted tracks the number of Pods evicted by rules that drain unready nodes.
+	PodsEvicted = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "node_readiness_pods_evicted_total",
+			Help: "Total number of pods evicted from unready nodes per rule",
+		},
+		[]string{"rule"},
+	)
+
+	// OrphanedTaints tracks readiness taints on nodes that no live rule manages,
+	// as found by the last sweep.
+	OrphanedTaints = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "node_readiness_orphaned_taints",
+			Help: "Number of nodes carrying a readiness taint that no rule manages, by taint key and effect",
+		},
+		[]string{"taint_key", "effect"},
+	)
+
+	// OrphanedTaintsRemoved tracks the number of orphaned readiness taints removed by the sweeper.
+	OrphanedTaintsRemoved = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "node_readiness_orphaned_taints_removed_total",
+			Help: "Total number of orphaned readiness taints removed from nodes, by taint key",
+		},
+		[]string{"taint_key"},
+	)
+
+	// LegacyBootstrapAnnotations tracks the bootstrap completion annotations of
+	// existing rules that are still keyed by rule name and await migration.
+	LegacyBootstrapAnnotations = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "node_readiness_legacy_bootstrap_annotations",
+			Help: "Number of bootstrap completion annotations still keyed by rule name, as of the last migration sweep",
+		},
+	)
+
+	// LegacyBootstrapAnnotationsMigrated tracks the number of legacy bootstrap
+	// completion annotations migrated to the UID-based key.
+	LegacyBootstrapAnnotationsMigrated = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "node_readiness_legacy_bootstrap_annotations_migrated_total",
+			Help: "Total number of bootstrap completion annotations migrated from the rule name to the rule UID key",
+		},
+	)
+
 	// RuleLastReconciliationTime tracks when a rule was last reconciled.
 	// This provides rule-level visibility for admins to detect stuck rules.
 	RuleLastReconciliationTime = prometheus.NewGaugeVec(
@@ -182,11 +252,18 @@ func init() {
 	metrics.Registry.MustRegister(TaintOperations)
 	metrics.Registry.MustRegister(EvaluationDuration)
 	metrics.Registry.MustRegister(Failures)
+	metrics.Registry.MustRegister(NodeTransitions)
 	metrics.Registry.MustRegister(BootstrapCompleted)
 	metrics.Registry.MustRegister(BootstrapDuration)
+	metrics.Registry.MustRegister(BootstrapRearmed)
 	metrics.Registry.MustRegister(ReconciliationLatency)
 	metrics.Registry.MustRegister(NodesByState)
 	metrics.Registry.MustRegister(ConditionEvaluationFailures)
 	metrics.Registry.MustRegister(RuleLastReconciliationTime)
+	metrics.Registry.MustRegister(PodsEvicted)
+	metrics.Registry.MustRegister(OrphanedTaints)
+	metrics.Registry.MustRegister(OrphanedTaintsRemoved)
+	metrics.Registry.MustRegister(LegacyBootstrapAnnotations)
+	metrics.Registry.MustRegister(LegacyBootstrapAnnotationsMigrated)
 	metrics.Registry.MustRegister(BuildInfo)
 }
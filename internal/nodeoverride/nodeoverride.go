@@ -0,0 +1,64 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nodeoverride defines the Node annotations operators set to override
+// how NodeReadinessRules handle a Node.
+package nodeoverride
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+//nolint:godot
+const (
+	// AnnotationKey overrides the handling of a Node for every rule.
+	//
+	// Value format: <action>[;expires=<RFC3339 time>]
+	// e.g.          force-hold;expires=2026-01-02T15:04:05Z
+	AnnotationKey = "readiness.k8s.io/override"
+
+	// AnnotationPrefix overrides the handling of a Node for a single rule. The
+	// suffix is derived from the rule name by RuleAnnotationKey, and takes
+	// precedence over AnnotationKey. The value format is the same.
+	AnnotationPrefix = AnnotationKey + "-"
+)
+
+const (
+	// maxNameLength is the maximum length of the name part of an annotation key.
+	maxNameLength = 63
+
+	// namePrefix is the name part of AnnotationPrefix.
+	namePrefix = "override-"
+
+	// hashLength is the length of the hash suffixed to truncated rule names.
+	hashLength = 8
+)
+
+// RuleAnnotationKey returns the Node annotation key that overrides a single
+// rule. It is the rule name after AnnotationPrefix, unless that does not fit
+// in the 63 characters the name part of an annotation key is limited to. The
+// name is then truncated and suffixed with a hash of the full name, which
+// keeps the keys of rules sharing a long prefix apart.
+func RuleAnnotationKey(ruleName string) string {
+	if len(namePrefix)+len(ruleName) <= maxNameLength {
+		return AnnotationPrefix + ruleName
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(ruleName))
+	truncated := ruleName[:maxNameLength-len(namePrefix)-1-hashLength]
+	return fmt.Sprintf("%s%s-%0*x", AnnotationPrefix, truncated, hashLength, h.Sum32())
+}
@@ -0,0 +1,45 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeoverride
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func TestRuleAnnotationKey(t *testing.T) {
+	g := NewWithT(t)
+
+	// Names that fit are used as they are.
+	g.Expect(RuleAnnotationKey("gpu-ready")).To(Equal("readiness.k8s.io/override-gpu-ready"))
+	fits := strings.Repeat("a", 54)
+	g.Expect(RuleAnnotationKey(fits)).To(Equal(AnnotationPrefix + fits))
+
+	// Longer names are truncated and told apart by a hash.
+	long := strings.Repeat("a", 250) + ".one"
+	other := strings.Repeat("a", 250) + ".two"
+	for _, name := range []string{strings.Repeat("a", 55), long, other} {
+		key := RuleAnnotationKey(name)
+		g.Expect(validation.IsQualifiedName(key)).To(BeEmpty(), key)
+		g.Expect(key).To(HavePrefix(AnnotationPrefix + strings.Repeat("a", 45) + "-"))
+		g.Expect(RuleAnnotationKey(name)).To(Equal(key))
+	}
+	g.Expect(RuleAnnotationKey(long)).NotTo(Equal(RuleAnnotationKey(other)))
+}
@@ -0,0 +1,171 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schedule evaluates the activation windows and expiry of a
+// NodeReadinessRule.
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// maxWindowMerges bounds how many overlapping windows are merged when looking
+// for the end of an active period. Past it, the transition is re-evaluated later.
+const maxWindowMerges = 100
+
+// parser accepts the standard five-field cron format and descriptors, like
+// CronJob schedules.
+var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+type window struct {
+	schedule cron.Schedule
+	duration time.Duration
+}
+
+// Schedule is a parsed rule schedule.
+type Schedule struct {
+	windows   []window
+	location  *time.Location
+	expiresAt time.Time
+}
+
+// Parse parses the rule's schedule. A rule without a schedule is always active.
+func Parse(rs readinessv1alpha1.RuleSchedule) (*Schedule, error) {
+	s := &Schedule{location: time.UTC, expiresAt: rs.ExpiresAt.Time}
+	if rs.TimeZone != "" {
+		location, err := time.LoadLocation(rs.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone %q: %w", rs.TimeZone, err)
+		}
+		s.location = location
+	}
+	for i, w := range rs.ActivationWindows {
+		// The time zone is set by timeZone only, as for CronJobs.
+		if strings.Contains(w.Start, "TZ=") {
+			return nil, fmt.Errorf("activation window %d: time zones must be set with timeZone", i)
+		}
+		schedule, err := parser.Parse(w.Start)
+		if err != nil {
+			return nil, fmt.Errorf("activation window %d: invalid start %q: %w", i, w.Start, err)
+		}
+		s.windows = append(s.windows, window{schedule: schedule, duration: time.Duration(w.DurationSeconds) * time.Second})
+	}
+	return s, nil
+}
+
+// Expired reports whether the schedule has expired at now.
+func (s *Schedule) Expired(now time.Time) bool {
+	return !s.expiresAt.IsZero() && !now.Before(s.expiresAt)
+}
+
+// Active reports whether the rule is in effect at now: it has not expired
+// and, if it has activation windows, one of them is open.
+func (s *Schedule) Active(now time.Time) bool {
+	if s.Expired(now) {
+		return false
+	}
+	if len(s.windows) == 0 {
+		return true
+	}
+	for _, w := range s.windows {
+		if w.coveredUntil(now.In(s.location)).After(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextTransition returns when the rule next becomes active or inactive, or
+// expires, after now. It returns the zero time when no transition is due.
+func (s *Schedule) NextTransition(now time.Time) time.Time {
+	if s.Expired(now) {
+		return time.Time{}
+	}
+
+	var next time.Time
+	if len(s.windows) > 0 {
+		if s.Active(now) {
+			next = s.activeUntil(now)
+		} else {
+			for _, w := range s.windows {
+				if start := w.schedule.Next(now.In(s.location)); !start.IsZero() && (next.IsZero() || start.Before(next)) {
+					next = start
+				}
+			}
+		}
+	}
+
+	if !s.expiresAt.IsZero() && (next.IsZero() || s.expiresAt.Before(next)) {
+		next = s.expiresAt
+	}
+	return next
+}
+
+// activeUntil returns when the active period the rule is in at now ends,
+// merging windows that open before the previous ones close.
+func (s *Schedule) activeUntil(now time.Time) time.Time {
+	t := now.In(s.location)
+	for range maxWindowMerges {
+		end := t
+		for _, w := range s.windows {
+			if until := w.coveredUntil(t); until.After(end) {
+				end = until
+			}
+		}
+		if !end.After(t) {
+			return t
+		}
+		t = end
+	}
+	return t
+}
+
+// coveredUntil returns when the latest occurrence of the window that is open
+// at t closes, or t if the window is closed at t.
+func (w window) coveredUntil(t time.Time) time.Time {
+	start := w.latestStart(t.Add(-w.duration), t)
+	if start.IsZero() {
+		return t
+	}
+	return start.Add(w.duration)
+}
+
+// latestStart returns the latest start of the window in (after, t], or the
+// zero time if there is none. Cron schedules can only be stepped forward, so
+// rather than stepping through every start, it bisects for the latest x with
+// Next(x) <= t, which is less than a second before the latest start, as starts
+// fall on whole seconds.
+func (w window) latestStart(after, t time.Time) time.Time {
+	if next := w.schedule.Next(after); next.IsZero() || next.After(t) {
+		return time.Time{}
+	}
+	lo, hi := after, t
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2)
+		if next := w.schedule.Next(mid); !next.IsZero() && !next.After(t) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return w.schedule.Next(lo)
+}
@@ -0,0 +1,199 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+func date(hour, minute int) time.Time {
+	return time.Date(2026, time.March, 10, hour, minute, 0, 0, time.UTC)
+}
+
+// nightly opens a two hour window at 02:00 every day.
+var nightly = readinessv1alpha1.ActivationWindow{Start: "0 2 * * *", DurationSeconds: 7200}
+
+func TestSchedule(t *testing.T) {
+	tests := []struct {
+		name       string
+		schedule   readinessv1alpha1.RuleSchedule
+		now        time.Time
+		wantActive bool
+		wantNext   time.Time
+	}{
+		{
+			name:       "no windows",
+			schedule:   readinessv1alpha1.RuleSchedule{TimeZone: "UTC"},
+			now:        date(12, 0),
+			wantActive: true,
+		},
+		{
+			name:       "before the window",
+			schedule:   readinessv1alpha1.RuleSchedule{ActivationWindows: []readinessv1alpha1.ActivationWindow{nightly}},
+			now:        date(1, 0),
+			wantActive: false,
+			wantNext:   date(2, 0),
+		},
+		{
+			name:       "within the window",
+			schedule:   readinessv1alpha1.RuleSchedule{ActivationWindows: []readinessv1alpha1.ActivationWindow{nightly}},
+			now:        date(3, 0),
+			wantActive: true,
+			wantNext:   date(4, 0),
+		},
+		{
+			name:       "after the window",
+			schedule:   readinessv1alpha1.RuleSchedule{ActivationWindows: []readinessv1alpha1.ActivationWindow{nightly}},
+			now:        date(4, 0),
+			wantActive: false,
+			wantNext:   date(2, 0).AddDate(0, 0, 1),
+		},
+		{
+			name: "overlapping windows are merged",
+			schedule: readinessv1alpha1.RuleSchedule{ActivationWindows: []readinessv1alpha1.ActivationWindow{
+				nightly,
+				{Start: "30 3 * * *", DurationSeconds: 3600},
+			}},
+			now:        date(3, 0),
+			wantActive: true,
+			wantNext:   date(4, 30),
+		},
+		{
+			name: "windows in a time zone",
+			schedule: readinessv1alpha1.RuleSchedule{
+				ActivationWindows: []readinessv1alpha1.ActivationWindow{nightly},
+				TimeZone:          "Asia/Tokyo",
+			},
+			now:        date(17, 30), // 02:30 in Tokyo
+			wantActive: true,
+			wantNext:   date(19, 0),
+		},
+		{
+			name: "expiry before the next transition",
+			schedule: readinessv1alpha1.RuleSchedule{
+				ActivationWindows: []readinessv1alpha1.ActivationWindow{nightly},
+				ExpiresAt:         metav1.NewTime(date(3, 0)),
+			},
+			now:        date(2, 30),
+			wantActive: true,
+			wantNext:   date(3, 0),
+		},
+		{
+			name:       "expired",
+			schedule:   readinessv1alpha1.RuleSchedule{ExpiresAt: metav1.NewTime(date(3, 0))},
+			now:        date(3, 0),
+			wantActive: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s, err := Parse(tt.schedule)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s.Active(tt.now)).To(Equal(tt.wantActive))
+			g.Expect(s.NextTransition(tt.now)).To(BeTemporally("==", tt.wantNext))
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule readinessv1alpha1.RuleSchedule
+		wantErr  string
+	}{
+		{
+			name:     "unknown time zone",
+			schedule: readinessv1alpha1.RuleSchedule{TimeZone: "Mars/Olympus"},
+			wantErr:  "unknown time zone",
+		},
+		{
+			name: "invalid cron expression",
+			schedule: readinessv1alpha1.RuleSchedule{ActivationWindows: []readinessv1alpha1.ActivationWindow{
+				{Start: "0 25 * * *", DurationSeconds: 60},
+			}},
+			wantErr: "activation window 0: invalid start",
+		},
+		{
+			name: "time zone in the cron expression",
+			schedule: readinessv1alpha1.RuleSchedule{ActivationWindows: []readinessv1alpha1.ActivationWindow{
+				{Start: "CRON_TZ=UTC 0 2 * * *", DurationSeconds: 60},
+			}},
+			wantErr: "must be set with timeZone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := Parse(tt.schedule)
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+// countingSchedule counts the calls to the wrapped schedule's Next.
+type countingSchedule struct {
+	cron.Schedule
+	calls int
+}
+
+func (c *countingSchedule) Next(t time.Time) time.Time {
+	c.calls++
+	return c.Schedule.Next(t)
+}
+
+func TestWindowCoveredUntil(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    string
+		duration time.Duration
+		at       time.Time
+	}{
+		{name: "every minute for a week", start: "* * * * *", duration: 7 * 24 * time.Hour, at: date(12, 0).Add(30 * time.Second)},
+		{name: "nightly, open", start: "0 2 * * *", duration: 2 * time.Hour, at: date(3, 15)},
+		{name: "nightly, closed", start: "0 2 * * *", duration: 2 * time.Hour, at: date(5, 0)},
+		{name: "on the start", start: "*/15 * * * *", duration: time.Minute, at: date(3, 15)},
+		{name: "monthly, open for a month", start: "0 0 1 * *", duration: 31 * 24 * time.Hour, at: date(0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			parsed, err := parser.Parse(tt.start)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			// The reference steps through every start of the window.
+			want := tt.at
+			for start := parsed.Next(tt.at.Add(-tt.duration)); !start.After(tt.at); start = parsed.Next(start) {
+				want = start.Add(tt.duration)
+			}
+
+			counting := &countingSchedule{Schedule: parsed}
+			g.Expect(window{schedule: counting, duration: tt.duration}.coveredUntil(tt.at)).To(BeTemporally("==", want))
+			g.Expect(counting.calls).To(BeNumerically("<=", 64))
+		})
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+const (
+	// skipDryRunPromotionAnnotationKey, set to "true" on a rule, lets it be
+	// enforced without passing the dry run promotion gate, when the user
+	// enforcing it is granted skipDryRunPromotionVerb on the rule.
+	skipDryRunPromotionAnnotationKey = "readiness.k8s.io/skip-dry-run-promotion"
+
+	// skipDryRunPromotionVerb is the verb on nodereadinessrules that
+	// authorizes skipping the dry run promotion gate.
+	skipDryRunPromotionVerb = "skip-dry-run-promotion"
+)
+
+// DryRunPromotionPolicy gates enforcing a rule on how long its current spec
+// has run in dry run mode and on the latest results of that dry run.
+type DryRunPromotionPolicy struct {
+	// MinDuration is how long the rule must have been in dry run.
+	MinDuration time.Duration
+
+	// MaxRiskyOperations is the number of Nodes missing required conditions
+	// the dry run may report. Negative disables the threshold.
+	MaxRiskyOperations int
+
+	// MaxTaintsToAdd is the number of taints the dry run may report it would
+	// add. Negative disables the threshold.
+	MaxTaintsToAdd int
+}
+
+// validateDryRunPromotion requires rules to be created in dry run mode, and
+// only lets them leave it once they pass the DryRunPromotion policy, or when
+// they are annotated to skip it by a user authorized to. oldRule is nil on
+// create.
+func (w *NodeReadinessRuleWebhook) validateDryRunPromotion(
+	ctx context.Context,
+	oldRule, newRule *readinessv1alpha1.NodeReadinessRule,
+	now time.Time,
+) field.ErrorList {
+	allErrs := w.checkDryRunPromotion(oldRule, newRule, now)
+	if len(allErrs) == 0 || newRule.Annotations[skipDryRunPromotionAnnotationKey] != "true" {
+		return allErrs
+	}
+
+	annotationField := field.NewPath("metadata", "annotations").Key(skipDryRunPromotionAnnotationKey)
+	allowed, reason, err := w.canSkipDryRunPromotion(ctx, newRule)
+	switch {
+	case err != nil:
+		return field.ErrorList{field.InternalError(annotationField, err)}
+	case !allowed:
+		msg := fmt.Sprintf("user is not allowed to %s nodereadinessrules", skipDryRunPromotionVerb)
+		if reason != "" {
+			msg += ": " + reason
+		}
+		return append(allErrs, field.Forbidden(annotationField, msg))
+	}
+	return nil
+}
+
+// canSkipDryRunPromotion reports whether the user making the admission
+// request is granted skipDryRunPromotionVerb on the rule, with a
+// SubjectAccessReview.
+func (w *NodeReadinessRuleWebhook) canSkipDryRunPromotion(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule) (bool, string, error) {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get the admission request: %w", err)
+	}
+
+	userInfo := req.UserInfo
+	extra := make(map[string]authorizationv1.ExtraValue, len(userInfo.Extra))
+	for key, value := range userInfo.Extra {
+		extra[key] = authorizationv1.ExtraValue(value)
+	}
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   userInfo.Username,
+			UID:    userInfo.UID,
+			Groups: userInfo.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:    readinessv1alpha1.GroupVersion.Group,
+				Resource: "nodereadinessrules",
+				Verb:     skipDryRunPromotionVerb,
+				Name:     rule.Name,
+			},
+		},
+	}
+	if err := w.Create(ctx, review); err != nil {
+		return false, "", fmt.Errorf("failed to review access: %w", err)
+	}
+	return review.Status.Allowed, review.Status.Reason, nil
+}
+
+// checkDryRunPromotion returns why the DryRunPromotion policy does not let
+// the rule be enforced, if it does not.
+func (w *NodeReadinessRuleWebhook) checkDryRunPromotion(oldRule, newRule *readinessv1alpha1.NodeReadinessRule, now time.Time) field.ErrorList {
+	policy := w.DryRunPromotion
+	if policy == nil || newRule.Spec.DryRun {
+		return nil
+	}
+	dryRunField := field.NewPath("spec", "dryRun")
+	hint := fmt.Sprintf("; users granted %q on nodereadinessrules can set the %s=true annotation to skip this check",
+		skipDryRunPromotionVerb, skipDryRunPromotionAnnotationKey)
+
+	if oldRule == nil {
+		return field.ErrorList{field.Forbidden(dryRunField,
+			"rules must be created with dryRun and pass the dry run promotion gate before they are enforced"+hint)}
+	}
+	if !oldRule.Spec.DryRun {
+		return nil
+	}
+
+	// Only the spec that ran in dry run can be promoted: other changes made
+	// along with disabling dryRun were never evaluated in dry run.
+	promotedSpec := oldRule.Spec.DeepCopy()
+	promotedSpec.DryRun = false
+	if !equality.Semantic.DeepEqual(*promotedSpec, newRule.Spec) {
+		return field.ErrorList{field.Forbidden(field.NewPath("spec"),
+			"dryRun must be disabled on its own, without changing the rest of the spec, so that the enforced spec is the one that ran in dry run"+hint)}
+	}
+
+	status := oldRule.Status
+	if status.DryRunStartTime.IsZero() || status.ObservedGeneration != oldRule.Generation {
+		return field.ErrorList{field.Forbidden(dryRunField,
+			"the controller has not evaluated the rule's current spec in dry run yet"+hint)}
+	}
+
+	var reasons []string
+	if elapsed := now.Sub(status.DryRunStartTime.Time); elapsed < policy.MinDuration {
+		reasons = append(reasons, fmt.Sprintf("rule has been in dry run for %s, less than the required %s",
+			elapsed.Round(time.Second), policy.MinDuration))
+	}
+	results := status.DryRunResults
+	if exceeds(results.RiskyOperations, policy.MaxRiskyOperations) {
+		reasons = append(reasons, fmt.Sprintf("dry run reports %d nodes with missing conditions, more than the allowed %d",
+			*results.RiskyOperations, policy.MaxRiskyOperations))
+	}
+	if exceeds(results.TaintsToAdd, policy.MaxTaintsToAdd) {
+		reasons = append(reasons, fmt.Sprintf("dry run reports %d taints to add, more than the allowed %d",
+			*results.TaintsToAdd, policy.MaxTaintsToAdd))
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(dryRunField, strings.Join(reasons, "; ")+hint)}
+}
+
+// exceeds reports whether a dry run count is above a non-negative threshold.
+func exceeds(count *int32, threshold int) bool {
+	return threshold >= 0 && count != nil && int(*count) > threshold
+}
@@ -0,0 +1,138 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/controller"
+)
+
+// ruleImpact is what a rule would do to the cluster's Nodes once admitted.
+type ruleImpact struct {
+	// matched is the number of Nodes the rule selects.
+	matched int
+	// tainted is the number of selected Nodes the controller would taint, as
+	// they lack the taint and do not meet the rule's conditions, and neither
+	// completed bootstrap nor are exempted or released by an override.
+	tainted int
+	// immediate is the part of tainted the rule would taint straight away,
+	// given the current stage of its rollout.
+	immediate int
+	// unseenConditions are the rule's conditions no selected Node reports.
+	unseenConditions []string
+}
+
+// previewImpact evaluates the rule's spec against the cached Nodes with the
+// controller's evaluation. It returns nil when the Nodes cannot be listed, as
+// the preview is best effort.
+func (w *NodeReadinessRuleWebhook) previewImpact(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule) *ruleImpact {
+	selector, err := metav1.LabelSelectorAsSelector(&rule.Spec.NodeSelector)
+	if err != nil {
+		return nil
+	}
+	nodeList := &corev1.NodeList{}
+	if err := w.List(ctx, nodeList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		ctrl.Log.Error(err, "Failed to list nodes for impact preview")
+		return nil
+	}
+
+	evaluator := w.Evaluator
+	if evaluator == nil {
+		evaluator = &controller.RuleReadinessController{}
+	}
+	// The rule is compared with the Nodes as of its creation, which is now
+	// for rules being created.
+	now := time.Now()
+	if rule.CreationTimestamp.IsZero() {
+		rule = rule.DeepCopy()
+		rule.CreationTimestamp = metav1.NewTime(now)
+	}
+
+	impact := &ruleImpact{}
+	seen := make(map[string]bool, len(rule.Spec.Conditions))
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		preview := evaluator.PreviewNode(rule, node, now)
+		if preview.OutOfScope {
+			continue
+		}
+		impact.matched++
+		for _, condition := range node.Status.Conditions {
+			seen[string(condition.Type)] = true
+		}
+		if preview.AddsTaint {
+			impact.tainted++
+			if preview.Enforced {
+				impact.immediate++
+			}
+		}
+	}
+
+	for _, condReq := range rule.Spec.Conditions {
+		if !seen[condReq.Type] {
+			impact.unseenConditions = append(impact.unseenConditions, condReq.Type)
+		}
+	}
+	return impact
+}
+
+// generateImpactWarnings summarizes the impact of the rule on the Nodes it
+// selects, e.g. "matches 812 node(s); 640 would be tainted immediately". Rules
+// that select no Node yet have no impact to report.
+func generateImpactWarnings(spec readinessv1alpha1.NodeReadinessRuleSpec, impact *ruleImpact) admission.Warnings {
+	if impact == nil || impact.matched == 0 {
+		return nil
+	}
+
+	parts := []string{fmt.Sprintf("matches %d node(s)", impact.matched)}
+	switch {
+	case spec.DryRun:
+		parts = append(parts, fmt.Sprintf("%d would be tainted once dryRun is disabled", impact.tainted))
+	case impact.immediate < impact.tainted:
+		parts = append(parts, fmt.Sprintf("%d would be tainted immediately and %d as the rollout progresses",
+			impact.immediate, impact.tainted-impact.immediate))
+	default:
+		parts = append(parts, fmt.Sprintf("%d would be tainted immediately", impact.tainted))
+	}
+	for _, conditionType := range impact.unseenConditions {
+		parts = append(parts, fmt.Sprintf("condition '%s' not seen on any node", conditionType))
+	}
+	return admission.Warnings{"IMPACT: " + strings.Join(parts, "; ")}
+}
+
+// validateImpact rejects rules that would taint more nodes straight away
+// than MaxImmediateTaints allows, unless they are in dry run mode.
+func (w *NodeReadinessRuleWebhook) validateImpact(spec readinessv1alpha1.NodeReadinessRuleSpec, impact *ruleImpact) field.ErrorList {
+	if w.MaxImmediateTaints <= 0 || impact == nil || spec.DryRun || impact.immediate <= w.MaxImmediateTaints {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(field.NewPath("spec", "dryRun"), fmt.Sprintf(
+		"rule would taint %d nodes immediately, more than the limit of %d; apply it with dryRun first, or roll it out progressively",
+		impact.immediate, w.MaxImmediateTaints))}
+}
@@ -19,20 +19,40 @@ package webhook
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/controller"
+	"sigs.k8s.io/node-readiness-controller/internal/schedule"
 )
 
 // NodeReadinessRuleWebhook validates NodeReadinessRule resources.
 type NodeReadinessRuleWebhook struct {
 	client.Client
+
+	// MaxImmediateTaints rejects rules that would taint more nodes as soon as
+	// they are admitted, unless they are in dry run mode. Zero disables the limit.
+	MaxImmediateTaints int
+
+	// Evaluator evaluates rules against the cluster's Nodes for the impact
+	// preview, the same way the controller enforces them. Nil evaluates them
+	// with the controller's defaults.
+	Evaluator *controller.RuleReadinessController
+
+	// DryRunPromotion, when set, requires rules to be created in dry run mode
+	// and gates disabling it on the rule's dry run. Nil disables the gate.
+	DryRunPromotion *DryRunPromotionPolicy
 }
 
 // NewNodeReadinessRuleWebhook creates a new webhook.
@@ -52,6 +72,12 @@ func (w *NodeReadinessRuleWebhook) validateNodeReadinessRule(ctx context.Context
 	// Check for conflicting rules (same taint key)
 	allErrs = append(allErrs, w.validateTaintConflicts(ctx, rule, isUpdate)...)
 
+	// Check the successor that takes over the taint on deletion
+	allErrs = append(allErrs, w.validateSuccessor(ctx, rule)...)
+
+	// Check that no other rule claims the same bootstrap completions
+	allErrs = append(allErrs, w.validateBootstrapID(ctx, rule)...)
+
 	return allErrs
 }
 
@@ -71,6 +97,72 @@ func (w *NodeReadinessRuleWebhook) validateSpec(
 		allErrs = append(allErrs, field.Required(field.NewPath("spec", "nodeSelector"), "nodeSelector must not be empty"))
 	}
 
+	// validate flapDetection is not combined with bootstrap-only mode; checked on
+	// update too, as flapDetection can be changed after creation.
+	if spec.FlapDetection.TransitionThreshold > 0 &&
+		spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec", "flapDetection"),
+			"flapDetection is not supported with bootstrap-only enforcementMode",
+		))
+	}
+
+	// validate drain is not combined with bootstrap-only mode; checked on
+	// update too, as drain can be changed after creation.
+	if spec.Drain.AfterSeconds > 0 &&
+		spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec", "drain"),
+			"drain is not supported with bootstrap-only enforcementMode",
+		))
+	}
+
+	// validate bootstrapRearmTriggers is only used with bootstrap-only mode;
+	// checked on update too, as the triggers can be changed after creation.
+	if len(spec.BootstrapRearmTriggers) > 0 &&
+		spec.EnforcementMode != readinessv1alpha1.EnforcementModeBootstrapOnly {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec", "bootstrapRearmTriggers"),
+			"bootstrapRearmTriggers is only supported with bootstrap-only enforcementMode",
+		))
+	}
+
+	// validate nodeScope is only used with bootstrap-only mode; checked on
+	// update too, as the scope can be changed after creation.
+	if spec.NodeScope.Type != "" &&
+		spec.EnforcementMode != readinessv1alpha1.EnforcementModeBootstrapOnly {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec", "nodeScope"),
+			"nodeScope is only supported with bootstrap-only enforcementMode",
+		))
+	}
+
+	// validate the schedule's cron expressions and time zone, which CEL cannot
+	// parse; checked on update too, as the schedule can be changed after creation.
+	if _, err := schedule.Parse(spec.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "schedule"), spec.Schedule, err.Error()))
+	}
+
+	// validate the rollout; checked on update too, as the stages can be
+	// changed after creation.
+	allErrs = append(allErrs, w.validateRollout(spec)...)
+
+	// validate the bootstrap identity is only used with bootstrap-only mode.
+	if spec.EnforcementMode != readinessv1alpha1.EnforcementModeBootstrapOnly {
+		if spec.BootstrapID != "" {
+			allErrs = append(allErrs, field.Forbidden(
+				field.NewPath("spec", "bootstrapID"),
+				"bootstrapID is only supported with bootstrap-only enforcementMode",
+			))
+		}
+		if spec.BootstrapAdoptionPolicy != "" {
+			allErrs = append(allErrs, field.Forbidden(
+				field.NewPath("spec", "bootstrapAdoptionPolicy"),
+				"bootstrapAdoptionPolicy is only supported with bootstrap-only enforcementMode",
+			))
+		}
+	}
+
 	// skip below checks for update because `enforcementMode`, `conditions`,
 	// and `conditionPolicy` are immutable as constrained by CEL XValidation rules.
 	if isUpdate {
@@ -98,6 +190,78 @@ func (w *NodeReadinessRuleWebhook) validateSpec(
 		))
 	}
 
+	// taintEscalation is immutable, so it is validated on create only.
+	allErrs = append(allErrs, w.validateTaintEscalation(spec)...)
+
+	return allErrs
+}
+
+// taintEffectSeverity orders taint effects from least to most restrictive.
+var taintEffectSeverity = map[corev1.TaintEffect]int{
+	corev1.TaintEffectPreferNoSchedule: 1,
+	corev1.TaintEffectNoSchedule:       2,
+	corev1.TaintEffectNoExecute:        3,
+}
+
+// validateTaintEscalation checks that the escalation ladder only ever makes
+// the taint more restrictive, at increasing delays.
+func (w *NodeReadinessRuleWebhook) validateTaintEscalation(spec readinessv1alpha1.NodeReadinessRuleSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(spec.TaintEscalation) == 0 {
+		return allErrs
+	}
+
+	ladderField := field.NewPath("spec", "taintEscalation")
+	if spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly {
+		return append(allErrs, field.Forbidden(ladderField,
+			"taintEscalation is not supported with bootstrap-only enforcementMode"))
+	}
+
+	previousEffect := spec.Taint.Effect
+	previousAfter := int32(0)
+	for i, step := range spec.TaintEscalation {
+		if taintEffectSeverity[step.Effect] <= taintEffectSeverity[previousEffect] {
+			allErrs = append(allErrs, field.Invalid(ladderField.Index(i).Child("effect"), step.Effect,
+				fmt.Sprintf("must be more restrictive than the previous effect %s", previousEffect)))
+		}
+		if step.AfterSeconds <= previousAfter {
+			allErrs = append(allErrs, field.Invalid(ladderField.Index(i).Child("afterSeconds"), step.AfterSeconds,
+				fmt.Sprintf("must be greater than the previous step's afterSeconds %d", previousAfter)))
+		}
+		previousEffect, previousAfter = step.Effect, step.AfterSeconds
+	}
+
+	return allErrs
+}
+
+// validateRollout checks that a rollout enforces the rule on increasing
+// percentages of the Nodes up to all of them, and is only used for continuous
+// rules.
+func (w *NodeReadinessRuleWebhook) validateRollout(spec readinessv1alpha1.NodeReadinessRuleSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(spec.Rollout.Stages) == 0 {
+		return allErrs
+	}
+
+	rolloutField := field.NewPath("spec", "rollout")
+	if spec.EnforcementMode == readinessv1alpha1.EnforcementModeBootstrapOnly {
+		return append(allErrs, field.Forbidden(rolloutField,
+			"rollout is not supported with bootstrap-only enforcementMode"))
+	}
+
+	previousPercent := int32(0)
+	for i, stage := range spec.Rollout.Stages {
+		if stage.Percent <= previousPercent {
+			allErrs = append(allErrs, field.Invalid(rolloutField.Child("stages").Index(i).Child("percent"), stage.Percent,
+				fmt.Sprintf("must be greater than the previous stage's percent %d", previousPercent)))
+		}
+		previousPercent = stage.Percent
+	}
+	if last := len(spec.Rollout.Stages) - 1; spec.Rollout.Stages[last].Percent != 100 {
+		allErrs = append(allErrs, field.Invalid(rolloutField.Child("stages").Index(last).Child("percent"),
+			spec.Rollout.Stages[last].Percent, "the last stage must enforce the rule on 100 percent of the Nodes"))
+	}
+
 	return allErrs
 }
 
@@ -125,24 +289,186 @@ func (w *NodeReadinessRuleWebhook) validateTaintConflicts(ctx context.Context, r
 			continue
 		}
 
-		// Check for same taint key and effect
-		if existingRule.Spec.Taint.Key == rule.Spec.Taint.Key &&
-			existingRule.Spec.Taint.Effect == rule.Spec.Taint.Effect {
+		// Skip a deleted rule that is waiting to hand its taint over to this rule
+		if handsOverTo(&existingRule, rule.Name) {
+			continue
+		}
+
+		// Check for same taint key and effect, at any step of either escalation ladder
+		if existingRule.Spec.Taint.Key != rule.Spec.Taint.Key {
+			continue
+		}
+		existingEffects := existingRule.Spec.GetTaintEffects()
+		for _, effect := range rule.Spec.GetTaintEffects() {
+			if !slices.Contains(existingEffects, effect) {
+				continue
+			}
 			// Check if node selectors overlap
 			if w.nodeSelectorsOverlap(rule.Spec.NodeSelector, existingRule.Spec.NodeSelector) {
 				allErrs = append(allErrs, field.Invalid(
 					taintField,
 					rule.Spec.Taint.Key,
 					fmt.Sprintf("conflicts with existing rule '%s' - same taint key '%s' and effect '%s' with overlapping node selectors",
-						existingRule.Name, rule.Spec.Taint.Key, rule.Spec.Taint.Effect),
+						existingRule.Name, rule.Spec.Taint.Key, effect),
 				))
 			}
+			break
 		}
 	}
 
 	return allErrs
 }
 
+// validateBootstrapID checks that no other rule uses the rule's bootstrapID,
+// as both would adopt each other's bootstrap completions. A rule being
+// deleted may share it, so that it can be recreated right away.
+func (w *NodeReadinessRuleWebhook) validateBootstrapID(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule) field.ErrorList {
+	if rule.Spec.BootstrapID == "" {
+		return nil
+	}
+	bootstrapIDField := field.NewPath("spec", "bootstrapID")
+
+	ruleList := &readinessv1alpha1.NodeReadinessRuleList{}
+	if err := w.List(ctx, ruleList); err != nil {
+		ctrl.Log.Error(err, "Failed to list rules for bootstrapID validation")
+		return field.ErrorList{field.InternalError(bootstrapIDField,
+			fmt.Errorf("failed to validate bootstrapID %q against existing rules: %w", rule.Spec.BootstrapID, err))}
+	}
+
+	for _, existingRule := range ruleList.Items {
+		if existingRule.Name == rule.Name || !existingRule.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if existingRule.Spec.BootstrapID == rule.Spec.BootstrapID {
+			return field.ErrorList{field.Duplicate(bootstrapIDField, rule.Spec.BootstrapID)}
+		}
+	}
+	return nil
+}
+
+// handsOverTo reports whether the rule is being deleted and hands its taint
+// over to the named successor.
+func handsOverTo(rule *readinessv1alpha1.NodeReadinessRule, successorName string) bool {
+	return !rule.DeletionTimestamp.IsZero() &&
+		rule.Spec.GetDeletionPolicy() == readinessv1alpha1.DeletionPolicyOrphanToRule &&
+		rule.Spec.SuccessorRuleName == successorName
+}
+
+// validateSuccessor checks that the successor named by an OrphanToRule
+// deletion policy is another rule managing the same taint. A successor that
+// does not exist yet is allowed, as it is typically created after this rule
+// is deleted; see generateSuccessorWarnings.
+func (w *NodeReadinessRuleWebhook) validateSuccessor(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if rule.Spec.GetDeletionPolicy() != readinessv1alpha1.DeletionPolicyOrphanToRule {
+		return allErrs
+	}
+
+	successorField := field.NewPath("spec", "successorRuleName")
+	name := rule.Spec.SuccessorRuleName
+	if name == rule.Name {
+		return append(allErrs, field.Invalid(successorField, name, "a rule cannot be its own successor"))
+	}
+	for _, msg := range validation.IsDNS1123Subdomain(name) {
+		allErrs = append(allErrs, field.Invalid(successorField, name, msg))
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	successor := &readinessv1alpha1.NodeReadinessRule{}
+	if err := w.Get(ctx, client.ObjectKey{Name: name}, successor); err != nil {
+		if apierrors.IsNotFound(err) {
+			return allErrs
+		}
+		// Fail closed, as for taint conflicts.
+		ctrl.Log.Error(err, "Failed to get successor rule for validation", "successor", name)
+		return append(allErrs, field.InternalError(
+			successorField,
+			fmt.Errorf("failed to validate successor rule %q: %w", name, err),
+		))
+	}
+
+	if successor.Spec.Taint.Key != rule.Spec.Taint.Key || successor.Spec.Taint.Effect != rule.Spec.Taint.Effect {
+		allErrs = append(allErrs, field.Invalid(
+			successorField,
+			name,
+			fmt.Sprintf("successor rule manages taint '%s:%s', not '%s:%s'",
+				successor.Spec.Taint.Key, successor.Spec.Taint.Effect, rule.Spec.Taint.Key, rule.Spec.Taint.Effect),
+		))
+	}
+	if !successor.DeletionTimestamp.IsZero() {
+		allErrs = append(allErrs, field.Invalid(successorField, name, "successor rule is being deleted"))
+	}
+
+	return allErrs
+}
+
+// generateSuccessorWarnings warns when the successor named by an
+// OrphanToRule deletion policy does not exist yet, as deleting the rule
+// then waits until the successor is created.
+func (w *NodeReadinessRuleWebhook) generateSuccessorWarnings(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule) admission.Warnings {
+	if rule.Spec.GetDeletionPolicy() != readinessv1alpha1.DeletionPolicyOrphanToRule {
+		return nil
+	}
+
+	successor := &readinessv1alpha1.NodeReadinessRule{}
+	if err := w.Get(ctx, client.ObjectKey{Name: rule.Spec.SuccessorRuleName}, successor); !apierrors.IsNotFound(err) {
+		return nil
+	}
+	return admission.Warnings{fmt.Sprintf(
+		"NOTE: successor rule '%s' does not exist yet. Deleting this rule keeps its taint in place until the successor is created",
+		rule.Spec.SuccessorRuleName)}
+}
+
+// maxTaintValueWarningNodes bounds how many Nodes are named in the taint value warning.
+const maxTaintValueWarningNodes = 5
+
+// generateTaintValueWarnings warns when Nodes the rule selects already carry
+// its taint with a different value. The controller matches taints by key and
+// effect only, so such taints are handled by the taint adoption policy.
+func (w *NodeReadinessRuleWebhook) generateTaintValueWarnings(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule) admission.Warnings {
+	selector, err := metav1.LabelSelectorAsSelector(&rule.Spec.NodeSelector)
+	if err != nil {
+		return nil
+	}
+	nodeList := &corev1.NodeList{}
+	if err := w.List(ctx, nodeList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		// Warnings are best effort, do not block the request.
+		ctrl.Log.Error(err, "Failed to list nodes for taint value warnings")
+		return nil
+	}
+
+	effects := rule.Spec.GetTaintEffects()
+	var nodeNames []string
+	for _, node := range nodeList.Items {
+		if slices.ContainsFunc(node.Spec.Taints, func(taint corev1.Taint) bool {
+			return taint.Key == rule.Spec.Taint.Key && slices.Contains(effects, taint.Effect) && taint.Value != rule.Spec.Taint.Value
+		}) {
+			nodeNames = append(nodeNames, node.Name)
+		}
+	}
+	if len(nodeNames) == 0 {
+		return nil
+	}
+
+	var consequence string
+	switch rule.Spec.GetTaintAdoptionPolicy() {
+	case readinessv1alpha1.TaintAdoptionPolicyRefuse:
+		consequence = "the rule will not manage these nodes until the taint is removed"
+	case readinessv1alpha1.TaintAdoptionPolicyReplace:
+		consequence = "the rule will replace these taints with its own"
+	default:
+		consequence = "the rule will adopt these taints and keep their value"
+	}
+	slices.Sort(nodeNames)
+	examples := nodeNames[:min(len(nodeNames), maxTaintValueWarningNodes)]
+	return admission.Warnings{fmt.Sprintf(
+		"NOTE: taint '%s' already exists with a different value on %d selected node(s) (e.g. %v); %s",
+		rule.Spec.Taint.Key, len(nodeNames), examples, consequence)}
+}
+
 // nodeSelectorsOverlap checks if two node selectors overlap.
 func (w *NodeReadinessRuleWebhook) nodeSelectorsOverlap(selector1, selector2 metav1.LabelSelector) bool {
 	// Convert to selectors
@@ -157,21 +483,29 @@ func (w *NodeReadinessRuleWebhook) nodeSelectorsOverlap(selector1, selector2 met
 	return selectorsOverlap(sel1, sel2)
 }
 
-// generateNoExecuteWarnings generates admission warnings for NoExecute taint usage.
+// generateNoExecuteWarnings generates admission warnings for NoExecute taint usage,
+// including escalation ladders that reach NoExecute.
 // NoExecute taints cause immediate pod eviction, which can be disruptive when
 // used with continuous enforcement mode.
-// Note: This is only called on CREATE since taint.effect is immutable after creation.
+// Note: This is only called on CREATE since taint.effect and taintEscalation are immutable after creation.
 func (w *NodeReadinessRuleWebhook) generateNoExecuteWarnings(spec readinessv1alpha1.NodeReadinessRuleSpec) admission.Warnings {
 	var warnings admission.Warnings
 
 	if spec.Taint.Effect != corev1.TaintEffectNoExecute {
+		// The escalation ladder may still reach NoExecute on nodes that stay unready.
+		for _, step := range spec.TaintEscalation {
+			if step.Effect == corev1.TaintEffectNoExecute {
+				warnings = append(warnings, fmt.Sprintf(
+					"CAUTION: taintEscalation reaches NoExecute after %ds, evicting pods from nodes that stay unready that long", step.AfterSeconds))
+			}
+		}
 		return warnings
 	}
 
 	// NoExecute with continuous mode is particularly risky
 	if spec.EnforcementMode == readinessv1alpha1.EnforcementModeContinuous {
 		warnings = append(warnings,
-			"CAUTION: NoExecute with continuous mode evicts pods when conditions fail, risking workload disruption. Consider NoSchedule or bootstrap-only")
+			"CAUTION: NoExecute with continuous mode evicts pods when conditions fail, ignoring PodDisruptionBudgets and risking workload disruption. Consider NoSchedule with drain, or bootstrap-only")
 	} else {
 		// NoExecute with bootstrap-only is less risky but still worth noting
 		warnings = append(warnings,
@@ -181,8 +515,13 @@ func (w *NodeReadinessRuleWebhook) generateNoExecuteWarnings(spec readinessv1alp
 	return warnings
 }
 
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
 // +kubebuilder:webhook:path=/validate-readiness-node-x-k8s-io-v1alpha1-nodereadinessrule,mutating=false,failurePolicy=fail,sideEffects=None,groups=readiness.node.x-k8s.io,resources=nodereadinessrules,verbs=create;update,versions=v1alpha1,name=vnodereadinessrule.readiness.node.x-k8s.io,admissionReviewVersions=v1
-// SetupWithManager sets up the webhook with the manager.
+// SetupWithManager sets up the webhook with the manager. Requests for v1beta1
+// rules are converted to v1alpha1 by the API server before being validated, and
+// when v1beta1 is registered in the manager's scheme, the conversion webhook is
+// served at /convert as well.
 func (w *NodeReadinessRuleWebhook) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, &readinessv1alpha1.NodeReadinessRule{}).
 		WithValidator(w).
@@ -196,9 +535,19 @@ func (w *NodeReadinessRuleWebhook) ValidateCreate(ctx context.Context, rule *rea
 	if allErrs := w.validateNodeReadinessRule(ctx, rule, false); len(allErrs) > 0 {
 		return nil, fmt.Errorf("validation failed: %v", allErrs)
 	}
+	if allErrs := w.validateDryRunPromotion(ctx, nil, rule, time.Now()); len(allErrs) > 0 {
+		return nil, fmt.Errorf("validation failed: %v", allErrs)
+	}
+	impact := w.previewImpact(ctx, rule)
+	if allErrs := w.validateImpact(rule.Spec, impact); len(allErrs) > 0 {
+		return nil, fmt.Errorf("validation failed: %v", allErrs)
+	}
 
 	// Generate warnings for NoExecute taint usage
 	warnings := w.generateNoExecuteWarnings(rule.Spec)
+	warnings = append(warnings, w.generateSuccessorWarnings(ctx, rule)...)
+	warnings = append(warnings, w.generateTaintValueWarnings(ctx, rule)...)
+	warnings = append(warnings, generateImpactWarnings(rule.Spec, impact)...)
 	return warnings, nil
 }
 
@@ -207,8 +556,24 @@ func (w *NodeReadinessRuleWebhook) ValidateUpdate(ctx context.Context, oldRule,
 	if allErrs := w.validateNodeReadinessRule(ctx, newRule, true); len(allErrs) > 0 {
 		return nil, fmt.Errorf("validation failed: %v", allErrs)
 	}
+	if allErrs := w.validateDryRunPromotion(ctx, oldRule, newRule, time.Now()); len(allErrs) > 0 {
+		return nil, fmt.Errorf("validation failed: %v", allErrs)
+	}
+	// Only spec changes are previewed and gated on their impact, so that
+	// finalizer, label and annotation edits, and rules being deleted, are
+	// always admitted.
+	var impact *ruleImpact
+	if newRule.DeletionTimestamp.IsZero() && !equality.Semantic.DeepEqual(oldRule.Spec, newRule.Spec) {
+		impact = w.previewImpact(ctx, newRule)
+		if allErrs := w.validateImpact(newRule.Spec, impact); len(allErrs) > 0 {
+			return nil, fmt.Errorf("validation failed: %v", allErrs)
+		}
+	}
 
-	return nil, nil
+	warnings := w.generateSuccessorWarnings(ctx, newRule)
+	warnings = append(warnings, w.generateTaintValueWarnings(ctx, newRule)...)
+	warnings = append(warnings, generateImpactWarnings(newRule.Spec, impact)...)
+	return warnings, nil
 }
 
 func (w *NodeReadinessRuleWebhook) ValidateDelete(ctx context.Context, rule *readinessv1alpha1.NodeReadinessRule) (admission.Warnings, error) {
@@ -18,18 +18,29 @@ package webhook
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	readinessv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/internal/nodeoverride"
 )
 
 func TestWebhook(t *testing.T) {
@@ -54,6 +65,32 @@ var _ = Describe("NodeReadinessRule Validation Webhook", func() {
 		webhook = NewNodeReadinessRuleWebhook(fakeClient)
 	})
 
+	Context("Rule Name Validation", func() {
+		newRule := func(name string) *readinessv1alpha1.NodeReadinessRule {
+			return &readinessv1alpha1.NodeReadinessRule{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: readinessv1alpha1.NodeReadinessRuleSpec{
+					Conditions: []readinessv1alpha1.ConditionRequirement{
+						{Type: "Ready", RequiredStatus: corev1.ConditionTrue},
+					},
+					NodeSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"node-role.kubernetes.io/worker": ""},
+					},
+					Taint: corev1.Taint{
+						Key:    "readiness.k8s.io/name-test-key",
+						Effect: corev1.TaintEffectNoSchedule,
+					},
+					EnforcementMode: readinessv1alpha1.EnforcementModeContinuous,
+				},
+			}
+		}
+
+		It("should accept a name too long to be used verbatim in the per-rule override annotation", func() {
+			_, err := webhook.ValidateCreate(ctx, newRule(strings.Repeat("a", 100)))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
 	Context("Spec Validation", func() {
 		It("should validate nodeSelector is not empty", func() {
 			rule := &readinessv1alpha1.NodeReadinessRule{
@@ -203,6 +240,247 @@ var _ = Describe("NodeReadinessRule Validation Webhook", func() {
 			})
 		})
 
+		Context("flapDetection with bootstrap-only", func() {
+			var spec readinessv1alpha1.NodeReadinessRuleSpec
+
+			BeforeEach(func() {
+				spec = readinessv1alpha1.NodeReadinessRuleSpec{
+					NodeSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					EnforcementMode: readinessv1alpha1.EnforcementModeBootstrapOnly,
+					FlapDetection: readinessv1alpha1.FlapDetection{
+						TransitionThreshold: 3,
+						WindowSeconds:       300,
+						CooldownSeconds:     600,
+					},
+				}
+			})
+
+			It("should allow flapDetection for continuous enforcement", func() {
+				spec.EnforcementMode = readinessv1alpha1.EnforcementModeContinuous
+				allErrs := webhook.validateSpec(spec, false)
+				Expect(allErrs).To(BeEmpty())
+			})
+
+			It("should forbid flapDetection with bootstrap-only enforcement", func() {
+				allErrs := webhook.validateSpec(spec, false)
+				Expect(allErrs).To(HaveLen(1))
+				Expect(allErrs[0].Field).To(Equal("spec.flapDetection"))
+				Expect(allErrs[0].Type).To(Equal(field.ErrorTypeForbidden))
+			})
+
+			It("should forbid flapDetection with bootstrap-only enforcement on update", func() {
+				allErrs := webhook.validateSpec(spec, true)
+				Expect(allErrs).To(HaveLen(1))
+				Expect(allErrs[0].Field).To(Equal("spec.flapDetection"))
+			})
+		})
+
+		Context("drain with bootstrap-only", func() {
+			var spec readinessv1alpha1.NodeReadinessRuleSpec
+
+			BeforeEach(func() {
+				spec = readinessv1alpha1.NodeReadinessRuleSpec{
+					NodeSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					EnforcementMode: readinessv1alpha1.EnforcementModeBootstrapOnly,
+					Drain:           readinessv1alpha1.Drain{AfterSeconds: 600},
+				}
+			})
+
+			It("should allow drain for continuous enforcement", func() {
+				spec.EnforcementMode = readinessv1alpha1.EnforcementModeContinuous
+				allErrs := webhook.validateSpec(spec, false)
+				Expect(allErrs).To(BeEmpty())
+			})
+
+			It("should forbid drain with bootstrap-only enforcement on create and update", func() {
+				for _, isUpdate := range []bool{false, true} {
+					allErrs := webhook.validateSpec(spec, isUpdate)
+					Expect(allErrs).To(HaveLen(1))
+					Expect(allErrs[0].Field).To(Equal("spec.drain"))
+					Expect(allErrs[0].Type).To(Equal(field.ErrorTypeForbidden))
+				}
+			})
+		})
+
+		Context("bootstrapRearmTriggers", func() {
+			var spec readinessv1alpha1.NodeReadinessRuleSpec
+
+			BeforeEach(func() {
+				spec = readinessv1alpha1.NodeReadinessRuleSpec{
+					NodeSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					EnforcementMode: readinessv1alpha1.EnforcementModeBootstrapOnly,
+					BootstrapRearmTriggers: []readinessv1alpha1.BootstrapRearmTrigger{
+						readinessv1alpha1.BootstrapRearmTriggerNodeReboot,
+					},
+				}
+			})
+
+			It("should allow bootstrapRearmTriggers for bootstrap-only enforcement", func() {
+				allErrs := webhook.validateSpec(spec, false)
+				Expect(allErrs).To(BeEmpty())
+			})
+
+			It("should forbid bootstrapRearmTriggers with continuous enforcement on create and update", func() {
+				spec.EnforcementMode = readinessv1alpha1.EnforcementModeContinuous
+				for _, isUpdate := range []bool{false, true} {
+					allErrs := webhook.validateSpec(spec, isUpdate)
+					Expect(allErrs).To(HaveLen(1))
+					Expect(allErrs[0].Field).To(Equal("spec.bootstrapRearmTriggers"))
+					Expect(allErrs[0].Type).To(Equal(field.ErrorTypeForbidden))
+				}
+			})
+		})
+
+		Context("schedule", func() {
+			It("should reject invalid cron expressions and time zones on create and update", func() {
+				spec := readinessv1alpha1.NodeReadinessRuleSpec{
+					NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					Schedule: readinessv1alpha1.RuleSchedule{
+						ActivationWindows: []readinessv1alpha1.ActivationWindow{{Start: "0 2 * * *", DurationSeconds: 3600}},
+						TimeZone:          "Europe/Berlin",
+					},
+				}
+				Expect(webhook.validateSpec(spec, false)).To(BeEmpty())
+
+				spec.Schedule.ActivationWindows[0].Start = "0 2 * *"
+				for _, isUpdate := range []bool{false, true} {
+					allErrs := webhook.validateSpec(spec, isUpdate)
+					Expect(allErrs).To(HaveLen(1))
+					Expect(allErrs[0].Field).To(Equal("spec.schedule"))
+				}
+
+				spec.Schedule.ActivationWindows[0].Start = "0 2 * * *"
+				spec.Schedule.TimeZone = "Europe/Atlantis"
+				allErrs := webhook.validateSpec(spec, false)
+				Expect(allErrs).To(HaveLen(1))
+				Expect(allErrs[0].Detail).To(ContainSubstring("unknown time zone"))
+			})
+		})
+
+		Context("rollout", func() {
+			It("should require increasing stage percents on create and update", func() {
+				spec := readinessv1alpha1.NodeReadinessRuleSpec{
+					NodeSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					EnforcementMode: readinessv1alpha1.EnforcementModeContinuous,
+					Rollout: readinessv1alpha1.Rollout{Stages: []readinessv1alpha1.RolloutStage{
+						{Percent: 10, PauseSeconds: 3600},
+						{Percent: 50, PauseSeconds: 3600},
+						{Percent: 100},
+					}},
+				}
+				Expect(webhook.validateSpec(spec, false)).To(BeEmpty())
+
+				spec.Rollout.Stages[1].Percent = 10
+				for _, isUpdate := range []bool{false, true} {
+					allErrs := webhook.validateSpec(spec, isUpdate)
+					Expect(allErrs).To(HaveLen(1))
+					Expect(allErrs[0].Field).To(Equal("spec.rollout.stages[1].percent"))
+				}
+			})
+
+			It("should require the last stage to enforce the rule on all nodes", func() {
+				spec := readinessv1alpha1.NodeReadinessRuleSpec{
+					NodeSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					EnforcementMode: readinessv1alpha1.EnforcementModeContinuous,
+					Rollout: readinessv1alpha1.Rollout{Stages: []readinessv1alpha1.RolloutStage{
+						{Percent: 10, PauseSeconds: 3600},
+						{Percent: 50},
+					}},
+				}
+				for _, isUpdate := range []bool{false, true} {
+					allErrs := webhook.validateSpec(spec, isUpdate)
+					Expect(allErrs).To(HaveLen(1))
+					Expect(allErrs[0].Field).To(Equal("spec.rollout.stages[1].percent"))
+					Expect(allErrs[0].Detail).To(ContainSubstring("100 percent"))
+				}
+			})
+
+			It("should forbid a rollout with bootstrap-only enforcement", func() {
+				spec := readinessv1alpha1.NodeReadinessRuleSpec{
+					NodeSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					EnforcementMode: readinessv1alpha1.EnforcementModeBootstrapOnly,
+					Rollout:         readinessv1alpha1.Rollout{Stages: []readinessv1alpha1.RolloutStage{{Percent: 10}}},
+				}
+				allErrs := webhook.validateSpec(spec, true)
+				Expect(allErrs).To(HaveLen(1))
+				Expect(allErrs[0].Field).To(Equal("spec.rollout"))
+				Expect(allErrs[0].Type).To(Equal(field.ErrorTypeForbidden))
+			})
+		})
+
+		Context("nodeScope", func() {
+			It("should forbid nodeScope with continuous enforcement on create and update", func() {
+				spec := readinessv1alpha1.NodeReadinessRuleSpec{
+					NodeSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					EnforcementMode: readinessv1alpha1.EnforcementModeBootstrapOnly,
+					NodeScope:       readinessv1alpha1.NodeScope{Type: readinessv1alpha1.NodeScopeTypeCreatedAfterRule},
+				}
+				Expect(webhook.validateSpec(spec, false)).To(BeEmpty())
+
+				spec.EnforcementMode = readinessv1alpha1.EnforcementModeContinuous
+				for _, isUpdate := range []bool{false, true} {
+					allErrs := webhook.validateSpec(spec, isUpdate)
+					Expect(allErrs).To(HaveLen(1))
+					Expect(allErrs[0].Field).To(Equal("spec.nodeScope"))
+					Expect(allErrs[0].Type).To(Equal(field.ErrorTypeForbidden))
+				}
+			})
+		})
+
+		Context("taintEscalation", func() {
+			var spec readinessv1alpha1.NodeReadinessRuleSpec
+
+			BeforeEach(func() {
+				spec = readinessv1alpha1.NodeReadinessRuleSpec{
+					NodeSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					EnforcementMode: readinessv1alpha1.EnforcementModeContinuous,
+					Taint: corev1.Taint{
+						Key:    "readiness.k8s.io/test",
+						Effect: corev1.TaintEffectPreferNoSchedule,
+					},
+					TaintEscalation: []readinessv1alpha1.TaintEscalationStep{
+						{Effect: corev1.TaintEffectNoSchedule, AfterSeconds: 300},
+						{Effect: corev1.TaintEffectNoExecute, AfterSeconds: 3600},
+					},
+				}
+			})
+
+			It("should allow an increasingly restrictive ladder", func() {
+				allErrs := webhook.validateSpec(spec, false)
+				Expect(allErrs).To(BeEmpty())
+			})
+
+			It("should forbid taintEscalation with bootstrap-only enforcement", func() {
+				spec.EnforcementMode = readinessv1alpha1.EnforcementModeBootstrapOnly
+				allErrs := webhook.validateSpec(spec, false)
+				Expect(allErrs).To(HaveLen(1))
+				Expect(allErrs[0].Field).To(Equal("spec.taintEscalation"))
+				Expect(allErrs[0].Type).To(Equal(field.ErrorTypeForbidden))
+			})
+
+			It("should reject a step that does not make the taint more restrictive", func() {
+				spec.Taint.Effect = corev1.TaintEffectNoSchedule
+				allErrs := webhook.validateSpec(spec, false)
+				Expect(allErrs).To(HaveLen(1))
+				Expect(allErrs[0].Field).To(Equal("spec.taintEscalation[0].effect"))
+				Expect(allErrs[0].Type).To(Equal(field.ErrorTypeInvalid))
+			})
+
+			It("should reject steps that are not ordered by afterSeconds", func() {
+				spec.TaintEscalation[1].AfterSeconds = 300
+				allErrs := webhook.validateSpec(spec, false)
+				Expect(allErrs).To(HaveLen(1))
+				Expect(allErrs[0].Field).To(Equal("spec.taintEscalation[1].afterSeconds"))
+				Expect(allErrs[0].Type).To(Equal(field.ErrorTypeInvalid))
+			})
+
+			It("should warn when the ladder reaches NoExecute", func() {
+				warnings := webhook.generateNoExecuteWarnings(spec)
+				Expect(warnings).To(HaveLen(1))
+				Expect(warnings[0]).To(ContainSubstring("taintEscalation reaches NoExecute after 3600s"))
+			})
+		})
+
 		It("should accumulate errors across nodeSelector, defaultStatus, and conditionPolicy violations", func() {
 			spec := readinessv1alpha1.NodeReadinessRuleSpec{
 				NodeSelector:    metav1.LabelSelector{},                 // empty → ErrorTypeRequired
@@ -343,6 +621,49 @@ var _ = Describe("NodeReadinessRule Validation Webhook", func() {
 			Expect(allErrs).To(BeEmpty()) // No conflicts - different effects
 		})
 
+		It("should detect conflicts with an effect reached by an escalation ladder", func() {
+			existingRule := &readinessv1alpha1.NodeReadinessRule{
+				ObjectMeta: metav1.ObjectMeta{Name: "existing-rule"},
+				Spec: readinessv1alpha1.NodeReadinessRuleSpec{
+					Conditions: []readinessv1alpha1.ConditionRequirement{
+						{Type: "Ready", RequiredStatus: corev1.ConditionTrue},
+					},
+					Taint: corev1.Taint{
+						Key:    "readiness.k8s.io/ladder-key",
+						Effect: corev1.TaintEffectPreferNoSchedule,
+					},
+					TaintEscalation: []readinessv1alpha1.TaintEscalationStep{
+						{Effect: corev1.TaintEffectNoSchedule, AfterSeconds: 300},
+					},
+					EnforcementMode: readinessv1alpha1.EnforcementModeContinuous,
+				},
+			}
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(existingRule).
+				Build()
+			webhook = NewNodeReadinessRuleWebhook(fakeClient)
+
+			newRule := &readinessv1alpha1.NodeReadinessRule{
+				ObjectMeta: metav1.ObjectMeta{Name: "new-rule"},
+				Spec: readinessv1alpha1.NodeReadinessRuleSpec{
+					Conditions: []readinessv1alpha1.ConditionRequirement{
+						{Type: "NetworkReady", RequiredStatus: corev1.ConditionTrue},
+					},
+					Taint: corev1.Taint{
+						Key:    "readiness.k8s.io/ladder-key",
+						Effect: corev1.TaintEffectNoSchedule,
+					},
+					EnforcementMode: readinessv1alpha1.EnforcementModeContinuous,
+				},
+			}
+
+			allErrs := webhook.validateTaintConflicts(ctx, newRule, false)
+			Expect(allErrs).To(HaveLen(1))
+			Expect(allErrs[0].Detail).To(ContainSubstring("effect 'NoSchedule'"))
+		})
+
 		It("should allow updates to the same rule", func() {
 			// Create existing rule
 			existingRule := &readinessv1alpha1.NodeReadinessRule{
@@ -375,6 +696,490 @@ var _ = Describe("NodeReadinessRule Validation Webhook", func() {
 			allErrs := webhook.validateTaintConflicts(ctx, updatedRule, true) // isUpdate = true
 			Expect(allErrs).To(BeEmpty())                                     // No conflicts - updating same rule
 		})
+
+		It("should not conflict with a deleted rule handing its taint over", func() {
+			now := metav1.Now()
+			deletedRule := &readinessv1alpha1.NodeReadinessRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "old-rule",
+					DeletionTimestamp: &now,
+					Finalizers:        []string{"readiness.node.x-k8s.io/cleanup-taints"},
+				},
+				Spec: readinessv1alpha1.NodeReadinessRuleSpec{
+					Taint: corev1.Taint{
+						Key:    "readiness.k8s.io/handover-key",
+						Effect: corev1.TaintEffectNoSchedule,
+					},
+					DeletionPolicy:    readinessv1alpha1.DeletionPolicyOrphanToRule,
+					SuccessorRuleName: "new-rule",
+				},
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(deletedRule).
+				Build()
+			webhook = NewNodeReadinessRuleWebhook(fakeClient)
+
+			newRule := &readinessv1alpha1.NodeReadinessRule{
+				ObjectMeta: metav1.ObjectMeta{Name: "new-rule"},
+				Spec: readinessv1alpha1.NodeReadinessRuleSpec{
+					Taint: deletedRule.Spec.Taint,
+				},
+			}
+			Expect(webhook.validateTaintConflicts(ctx, newRule, false)).To(BeEmpty())
+
+			otherRule := newRule.DeepCopy()
+			otherRule.Name = "other-rule"
+			Expect(webhook.validateTaintConflicts(ctx, otherRule, false)).To(HaveLen(1))
+		})
+	})
+
+	Context("Successor Validation", func() {
+		var successor *readinessv1alpha1.NodeReadinessRule
+
+		orphaningRule := func(successorName string) *readinessv1alpha1.NodeReadinessRule {
+			return &readinessv1alpha1.NodeReadinessRule{
+				ObjectMeta: metav1.ObjectMeta{Name: "old-rule"},
+				Spec: readinessv1alpha1.NodeReadinessRuleSpec{
+					Taint: corev1.Taint{
+						Key:    "readiness.k8s.io/handover-key",
+						Effect: corev1.TaintEffectNoSchedule,
+					},
+					DeletionPolicy:    readinessv1alpha1.DeletionPolicyOrphanToRule,
+					SuccessorRuleName: successorName,
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			successor = &readinessv1alpha1.NodeReadinessRule{
+				ObjectMeta: metav1.ObjectMeta{Name: "new-rule"},
+				Spec: readinessv1alpha1.NodeReadinessRuleSpec{
+					Taint: corev1.Taint{
+						Key:    "readiness.k8s.io/handover-key",
+						Effect: corev1.TaintEffectNoSchedule,
+					},
+				},
+			}
+		})
+
+		It("should accept a successor managing the same taint", func() {
+			webhook = NewNodeReadinessRuleWebhook(fake.NewClientBuilder().WithScheme(scheme).WithObjects(successor).Build())
+
+			Expect(webhook.validateSuccessor(ctx, orphaningRule("new-rule"))).To(BeEmpty())
+			Expect(webhook.generateSuccessorWarnings(ctx, orphaningRule("new-rule"))).To(BeEmpty())
+		})
+
+		It("should reject a successor managing a different taint", func() {
+			successor.Spec.Taint.Effect = corev1.TaintEffectNoExecute
+			webhook = NewNodeReadinessRuleWebhook(fake.NewClientBuilder().WithScheme(scheme).WithObjects(successor).Build())
+
+			allErrs := webhook.validateSuccessor(ctx, orphaningRule("new-rule"))
+			Expect(allErrs).To(HaveLen(1))
+			Expect(allErrs[0].Field).To(Equal("spec.successorRuleName"))
+			Expect(allErrs[0].Detail).To(ContainSubstring("readiness.k8s.io/handover-key:NoExecute"))
+		})
+
+		It("should reject the rule itself as successor", func() {
+			allErrs := webhook.validateSuccessor(ctx, orphaningRule("old-rule"))
+			Expect(allErrs).To(HaveLen(1))
+			Expect(allErrs[0].Detail).To(ContainSubstring("its own successor"))
+		})
+
+		It("should reject an invalid successor name", func() {
+			allErrs := webhook.validateSuccessor(ctx, orphaningRule("New_Rule"))
+			Expect(allErrs).NotTo(BeEmpty())
+			Expect(allErrs[0].Field).To(Equal("spec.successorRuleName"))
+		})
+
+		It("should allow a successor that does not exist yet with a warning", func() {
+			Expect(webhook.validateSuccessor(ctx, orphaningRule("new-rule"))).To(BeEmpty())
+
+			warnings, err := webhook.ValidateCreate(ctx, orphaningRule("new-rule"))
+			Expect(err).To(HaveOccurred()) // nodeSelector is empty
+			Expect(warnings).To(BeNil())
+
+			rule := orphaningRule("new-rule")
+			rule.Spec.NodeSelector = metav1.LabelSelector{MatchLabels: map[string]string{"pool": "gpu"}}
+			warnings, err = webhook.ValidateCreate(ctx, rule)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("successor rule 'new-rule' does not exist yet")))
+		})
+
+		It("should ignore rules with other deletion policies", func() {
+			rule := orphaningRule("")
+			rule.Spec.DeletionPolicy = readinessv1alpha1.DeletionPolicyRetain
+			Expect(webhook.validateSuccessor(ctx, rule)).To(BeEmpty())
+			Expect(webhook.generateSuccessorWarnings(ctx, rule)).To(BeEmpty())
+		})
+	})
+
+	Context("Bootstrap Identity Validation", func() {
+		bootstrapRule := func(name, bootstrapID string) *readinessv1alpha1.NodeReadinessRule {
+			return &readinessv1alpha1.NodeReadinessRule{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: readinessv1alpha1.NodeReadinessRuleSpec{
+					EnforcementMode: readinessv1alpha1.EnforcementModeBootstrapOnly,
+					BootstrapID:     bootstrapID,
+				},
+			}
+		}
+
+		It("should reject a bootstrapID used by another rule", func() {
+			webhook = NewNodeReadinessRuleWebhook(fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(bootstrapRule("cni-ready", "cni")).Build())
+
+			allErrs := webhook.validateBootstrapID(ctx, bootstrapRule("cni-ready-v2", "cni"))
+			Expect(allErrs).To(HaveLen(1))
+			Expect(allErrs[0].Field).To(Equal("spec.bootstrapID"))
+			Expect(allErrs[0].Type).To(Equal(field.ErrorTypeDuplicate))
+
+			Expect(webhook.validateBootstrapID(ctx, bootstrapRule("cni-ready", "cni"))).To(BeEmpty())
+			Expect(webhook.validateBootstrapID(ctx, bootstrapRule("cni-ready-v2", "cni-v2"))).To(BeEmpty())
+		})
+
+		It("should allow sharing the bootstrapID of a rule being deleted", func() {
+			now := metav1.Now()
+			deleting := bootstrapRule("cni-ready", "cni")
+			deleting.Finalizers = []string{"readiness.node.x-k8s.io/cleanup-taints"}
+			deleting.DeletionTimestamp = &now
+			webhook = NewNodeReadinessRuleWebhook(fake.NewClientBuilder().WithScheme(scheme).WithObjects(deleting).Build())
+
+			Expect(webhook.validateBootstrapID(ctx, bootstrapRule("cni-ready-v2", "cni"))).To(BeEmpty())
+		})
+
+		It("should forbid the bootstrap identity with continuous enforcement", func() {
+			spec := readinessv1alpha1.NodeReadinessRuleSpec{
+				NodeSelector:            metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				EnforcementMode:         readinessv1alpha1.EnforcementModeContinuous,
+				BootstrapID:             "cni",
+				BootstrapAdoptionPolicy: readinessv1alpha1.BootstrapAdoptionPolicySameRuleName,
+			}
+			allErrs := webhook.validateSpec(spec, false)
+			Expect(allErrs).To(HaveLen(2))
+			Expect(allErrs[0].Field).To(Equal("spec.bootstrapID"))
+			Expect(allErrs[1].Field).To(Equal("spec.bootstrapAdoptionPolicy"))
+		})
+	})
+
+	Context("Taint Value Warnings", func() {
+		var rule *readinessv1alpha1.NodeReadinessRule
+
+		taintedNode := func(name, pool, value string) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"pool": pool}},
+				Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+					{Key: "readiness.k8s.io/cni", Value: value, Effect: corev1.TaintEffectNoSchedule},
+				}},
+			}
+		}
+
+		BeforeEach(func() {
+			rule = &readinessv1alpha1.NodeReadinessRule{
+				ObjectMeta: metav1.ObjectMeta{Name: "cni-rule"},
+				Spec: readinessv1alpha1.NodeReadinessRuleSpec{
+					Conditions: []readinessv1alpha1.ConditionRequirement{
+						{Type: "CNIReady", RequiredStatus: corev1.ConditionTrue},
+					},
+					NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "gpu"}},
+					Taint: corev1.Taint{
+						Key:    "readiness.k8s.io/cni",
+						Value:  "pending",
+						Effect: corev1.TaintEffectNoSchedule,
+					},
+					EnforcementMode: readinessv1alpha1.EnforcementModeContinuous,
+				},
+			}
+			webhook = NewNodeReadinessRuleWebhook(fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				taintedNode("gpu-1", "gpu", "provisioning"),
+				taintedNode("gpu-2", "gpu", "pending"),
+				taintedNode("cpu-1", "cpu", "provisioning"),
+			).Build())
+		})
+
+		It("should warn about selected nodes carrying the taint with a different value", func() {
+			// The impact preview follows the taint value warning.
+			warnings, err := webhook.ValidateCreate(ctx, rule)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(2))
+			Expect(warnings[0]).To(ContainSubstring("on 1 selected node(s) (e.g. [gpu-1])"))
+			Expect(warnings[0]).To(ContainSubstring("adopt these taints"))
+
+			// Updates that leave the spec unchanged are not previewed.
+			warnings, err = webhook.ValidateUpdate(ctx, rule, rule)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+		})
+
+		It("should describe the consequence of the taint adoption policy", func() {
+			rule.Spec.TaintAdoptionPolicy = readinessv1alpha1.TaintAdoptionPolicyRefuse
+			Expect(webhook.generateTaintValueWarnings(ctx, rule)).To(ConsistOf(ContainSubstring("will not manage these nodes")))
+
+			rule.Spec.TaintAdoptionPolicy = readinessv1alpha1.TaintAdoptionPolicyReplace
+			Expect(webhook.generateTaintValueWarnings(ctx, rule)).To(ConsistOf(ContainSubstring("replace these taints")))
+		})
+
+		It("should not warn when the values match", func() {
+			rule.Spec.Taint.Value = "provisioning"
+			rule.Spec.NodeSelector = metav1.LabelSelector{MatchLabels: map[string]string{"pool": "cpu"}}
+			Expect(webhook.generateTaintValueWarnings(ctx, rule)).To(BeEmpty())
+		})
+	})
+
+	Context("Impact Preview", func() {
+		var rule *readinessv1alpha1.NodeReadinessRule
+
+		poolNode := func(name string, ready corev1.ConditionStatus, tainted bool) *corev1.Node {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"pool": "gpu"}}}
+			if ready != "" {
+				node.Status.Conditions = []corev1.NodeCondition{{Type: "GPUReady", Status: ready}}
+			}
+			if tainted {
+				node.Spec.Taints = []corev1.Taint{{Key: "readiness.k8s.io/gpu", Effect: corev1.TaintEffectNoSchedule}}
+			}
+			return node
+		}
+
+		BeforeEach(func() {
+			rule = &readinessv1alpha1.NodeReadinessRule{
+				ObjectMeta: metav1.ObjectMeta{Name: "gpu-rule"},
+				Spec: readinessv1alpha1.NodeReadinessRuleSpec{
+					Conditions: []readinessv1alpha1.ConditionRequirement{
+						{Type: "GPUReady", RequiredStatus: corev1.ConditionTrue},
+						{Type: "DriverReady", RequiredStatus: corev1.ConditionTrue},
+					},
+					NodeSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"pool": "gpu"}},
+					Taint:           corev1.Taint{Key: "readiness.k8s.io/gpu", Effect: corev1.TaintEffectNoSchedule},
+					EnforcementMode: readinessv1alpha1.EnforcementModeContinuous,
+				},
+			}
+			webhook = NewNodeReadinessRuleWebhook(fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				poolNode("gpu-1", corev1.ConditionFalse, false),
+				poolNode("gpu-2", corev1.ConditionTrue, false),
+				poolNode("gpu-3", corev1.ConditionFalse, true),
+				poolNode("gpu-4", "", false),
+			).Build())
+		})
+
+		It("should summarize the nodes the rule would taint", func() {
+			warnings, err := webhook.ValidateCreate(ctx, rule)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"IMPACT: matches 4 node(s); 3 would be tainted immediately; condition 'DriverReady' not seen on any node"))
+		})
+
+		It("should account for dry run and rollouts", func() {
+			rule.Spec.DryRun = true
+			Expect(webhook.ValidateCreate(ctx, rule)).To(ConsistOf(ContainSubstring("3 would be tainted once dryRun is disabled")))
+
+			rule.Spec.DryRun = false
+			rule.Spec.Rollout.Stages = []readinessv1alpha1.RolloutStage{{Percent: 50}, {Percent: 100}}
+			Expect(webhook.ValidateCreate(ctx, rule)).To(ConsistOf(
+				ContainSubstring("1 would be tainted immediately and 2 as the rollout progresses")))
+		})
+
+		It("should reject rules above the immediate taint limit unless in dry run", func() {
+			webhook.MaxImmediateTaints = 2
+			_, err := webhook.ValidateCreate(ctx, rule)
+			Expect(err).To(MatchError(ContainSubstring("rule would taint 3 nodes immediately, more than the limit of 2")))
+			dryRunRule := rule.DeepCopy()
+			dryRunRule.Spec.DryRun = true
+			_, err = webhook.ValidateUpdate(ctx, dryRunRule, rule)
+			Expect(err).To(HaveOccurred())
+
+			rule.Spec.DryRun = true
+			_, err = webhook.ValidateCreate(ctx, rule)
+			Expect(err).NotTo(HaveOccurred())
+
+			webhook.MaxImmediateTaints = 3
+			rule.Spec.DryRun = false
+			_, err = webhook.ValidateCreate(ctx, rule)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not gate updates that leave the spec unchanged", func() {
+			webhook.MaxImmediateTaints = 2
+
+			// Finalizer, label and annotation edits are admitted.
+			updated := rule.DeepCopy()
+			updated.Finalizers = []string{"readiness.node.x-k8s.io/cleanup-taints"}
+			updated.Labels = map[string]string{"team": "gpu"}
+			_, err := webhook.ValidateUpdate(ctx, rule, updated)
+			Expect(err).NotTo(HaveOccurred())
+
+			// So are rules being deleted, even when their spec changes.
+			updated.Spec.Conditions = updated.Spec.Conditions[:1]
+			updated.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			_, err = webhook.ValidateUpdate(ctx, rule, updated)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should evaluate the nodes the way the controller does", func() {
+			rule.UID = "gpu-rule-uid"
+			rule.Spec.EnforcementMode = readinessv1alpha1.EnforcementModeBootstrapOnly
+			exempt := poolNode("gpu-5", corev1.ConditionFalse, false)
+			exempt.Annotations = map[string]string{nodeoverride.RuleAnnotationKey(rule.Name): "exempt"}
+			released := poolNode("gpu-6", corev1.ConditionFalse, false)
+			released.Annotations = map[string]string{nodeoverride.RuleAnnotationKey(rule.Name): "force-release"}
+			bootstrapped := poolNode("gpu-7", corev1.ConditionFalse, false)
+			bootstrapped.Annotations = map[string]string{"readiness.k8s.io/bootstrap-completed-gpu-rule-uid": "{}"}
+			Expect(webhook.Create(ctx, exempt)).To(Succeed())
+			Expect(webhook.Create(ctx, released)).To(Succeed())
+			Expect(webhook.Create(ctx, bootstrapped)).To(Succeed())
+
+			Expect(webhook.ValidateCreate(ctx, rule)).To(ConsistOf(
+				ContainSubstring("matches 7 node(s); 3 would be tainted immediately")))
+		})
+	})
+
+	Context("Dry Run Promotion", func() {
+		var oldRule, newRule *readinessv1alpha1.NodeReadinessRule
+
+		BeforeEach(func() {
+			webhook.DryRunPromotion = &DryRunPromotionPolicy{
+				MinDuration:        24 * time.Hour,
+				MaxRiskyOperations: 0,
+				MaxTaintsToAdd:     -1,
+			}
+			oldRule = &readinessv1alpha1.NodeReadinessRule{
+				ObjectMeta: metav1.ObjectMeta{Name: "gpu-rule", Generation: 2},
+				Spec: readinessv1alpha1.NodeReadinessRuleSpec{
+					Conditions:      []readinessv1alpha1.ConditionRequirement{{Type: "GPUReady", RequiredStatus: corev1.ConditionTrue}},
+					NodeSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"pool": "gpu"}},
+					Taint:           corev1.Taint{Key: "readiness.k8s.io/gpu", Effect: corev1.TaintEffectNoSchedule},
+					EnforcementMode: readinessv1alpha1.EnforcementModeContinuous,
+					DryRun:          true,
+				},
+				Status: readinessv1alpha1.NodeReadinessRuleStatus{
+					ObservedGeneration: 2,
+					DryRunStartTime:    metav1.NewTime(time.Now().Add(-25 * time.Hour)),
+					DryRunResults: readinessv1alpha1.DryRunResults{
+						RiskyOperations: ptr.To[int32](0),
+						TaintsToAdd:     ptr.To[int32](7),
+						Summary:         "would add 7 taints",
+					},
+				},
+			}
+			newRule = oldRule.DeepCopy()
+			newRule.Spec.DryRun = false
+		})
+
+		It("should require rules to be created in dry run", func() {
+			_, err := webhook.ValidateCreate(ctx, newRule)
+			Expect(err).To(MatchError(ContainSubstring("rules must be created with dryRun")))
+
+			_, err = webhook.ValidateCreate(ctx, oldRule)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should allow disabling dry run once the rule passed the gate", func() {
+			_, err := webhook.ValidateUpdate(ctx, oldRule, newRule)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject spec changes made along with disabling dry run", func() {
+			newRule.Spec.NodeSelector = metav1.LabelSelector{MatchLabels: map[string]string{"pool": "cpu"}}
+			_, err := webhook.ValidateUpdate(ctx, oldRule, newRule)
+			Expect(err).To(MatchError(ContainSubstring("dryRun must be disabled on its own")))
+
+			newRule = oldRule.DeepCopy()
+			newRule.Spec.DryRun = false
+			newRule.Spec.Conditions[0].RequiredStatus = corev1.ConditionFalse
+			_, err = webhook.ValidateUpdate(ctx, oldRule, newRule)
+			Expect(err).To(MatchError(ContainSubstring("dryRun must be disabled on its own")))
+		})
+
+		It("should reject disabling dry run too early or above the thresholds", func() {
+			oldRule.Status.DryRunStartTime = metav1.NewTime(time.Now().Add(-time.Hour))
+			oldRule.Status.DryRunResults.RiskyOperations = ptr.To[int32](2)
+			webhook.DryRunPromotion.MaxTaintsToAdd = 5
+
+			_, err := webhook.ValidateUpdate(ctx, oldRule, newRule)
+			Expect(err).To(MatchError(SatisfyAll(
+				ContainSubstring("less than the required 24h0m0s"),
+				ContainSubstring("2 nodes with missing conditions, more than the allowed 0"),
+				ContainSubstring("7 taints to add, more than the allowed 5"),
+			)))
+		})
+
+		It("should reject disabling dry run before the current spec was evaluated", func() {
+			oldRule.Generation = 3
+			_, err := webhook.ValidateUpdate(ctx, oldRule, newRule)
+			Expect(err).To(MatchError(ContainSubstring("has not evaluated the rule's current spec in dry run yet")))
+		})
+
+		Context("with the skip annotation", func() {
+			var reviews []authorizationv1.SubjectAccessReviewSpec
+
+			// requestBy returns a context holding an admission request by the user.
+			requestBy := func(user string, groups ...string) context.Context {
+				return admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: user, Groups: groups},
+				}})
+			}
+
+			BeforeEach(func() {
+				reviews = nil
+				// Only members of the admins group may skip the gate.
+				webhook.Client = fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						review, ok := obj.(*authorizationv1.SubjectAccessReview)
+						if !ok {
+							return c.Create(ctx, obj, opts...)
+						}
+						reviews = append(reviews, review.Spec)
+						review.Status.Allowed = slices.Contains(review.Spec.Groups, "admins")
+						return nil
+					},
+				}).Build()
+				oldRule.Status = readinessv1alpha1.NodeReadinessRuleStatus{}
+				newRule.Annotations = map[string]string{skipDryRunPromotionAnnotationKey: "true"}
+			})
+
+			It("should let authorized users skip the gate", func() {
+				_, err := webhook.ValidateUpdate(requestBy("alice", "admins"), oldRule, newRule)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = webhook.ValidateCreate(requestBy("alice", "admins"), newRule)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(reviews).To(HaveLen(2))
+				Expect(reviews[0].User).To(Equal("alice"))
+				Expect(reviews[0].ResourceAttributes).To(Equal(&authorizationv1.ResourceAttributes{
+					Group:    readinessv1alpha1.GroupVersion.Group,
+					Resource: "nodereadinessrules",
+					Verb:     "skip-dry-run-promotion",
+					Name:     newRule.Name,
+				}))
+			})
+
+			It("should reject users who are not authorized to skip the gate", func() {
+				_, err := webhook.ValidateUpdate(requestBy("bob", "developers"), oldRule, newRule)
+				Expect(err).To(MatchError(SatisfyAll(
+					ContainSubstring("has not evaluated the rule's current spec in dry run yet"),
+					ContainSubstring("user is not allowed to skip-dry-run-promotion nodereadinessrules"),
+				)))
+				_, err = webhook.ValidateCreate(requestBy("bob", "developers"), newRule)
+				Expect(err).To(MatchError(ContainSubstring("user is not allowed to skip-dry-run-promotion")))
+			})
+
+			It("should not review access when the gate passes", func() {
+				oldRule.Status = readinessv1alpha1.NodeReadinessRuleStatus{
+					ObservedGeneration: 2,
+					DryRunStartTime:    metav1.NewTime(time.Now().Add(-25 * time.Hour)),
+					DryRunResults:      readinessv1alpha1.DryRunResults{RiskyOperations: ptr.To[int32](0)},
+				}
+				_, err := webhook.ValidateUpdate(requestBy("bob", "developers"), oldRule, newRule)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reviews).To(BeEmpty())
+			})
+		})
+
+		It("should not gate rules when the policy is disabled", func() {
+			webhook.DryRunPromotion = nil
+			oldRule.Status = readinessv1alpha1.NodeReadinessRuleStatus{}
+			_, err := webhook.ValidateUpdate(ctx, oldRule, newRule)
+			Expect(err).NotTo(HaveOccurred())
+		})
 	})
 
 	Context("Node Selector Overlap Detection", func() {
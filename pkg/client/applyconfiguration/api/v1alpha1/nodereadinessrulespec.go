@@ -0,0 +1,357 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	apiv1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+)
+
+// NodeReadinessRuleSpecApplyConfiguration represents a declarative configuration of the NodeReadinessRuleSpec type for use
+// with apply.
+//
+// NodeReadinessRuleSpec defines the desired state of NodeReadinessRule.
+type NodeReadinessRuleSpecApplyConfiguration struct {
+	// conditions contains a list of the Node conditions that defines the specific
+	// criteria that must be met for taints to be managed on the target Node.
+	// The presence or status of these conditions directly triggers the application or removal of Node taints.
+	Conditions []ConditionRequirementApplyConfiguration `json:"conditions,omitempty"`
+	// enforcementMode specifies how the controller maintains the desired state.
+	// enforcementMode is one of bootstrap-only, continuous.
+	// "bootstrap-only" applies the configuration once during initial setup.
+	// "continuous" ensures the state is monitored and corrected throughout the resource lifecycle.
+	EnforcementMode *apiv1alpha1.EnforcementMode `json:"enforcementMode,omitempty"`
+	// taint defines the specific Taint (Key, Value, and Effect) to be managed
+	// on Nodes that meet the defined condition criteria.
+	//
+	// The taint key must follow Kubernetes qualified name format: prefix/name
+	// where prefix is 'readiness.k8s.io' (DNS subdomain) and name is a qualified
+	// name (max 63 chars, alphanumeric, '-', '_', '.', must start and end with alphanumeric).
+	// ref: git.k8s.io/kubernetes/staging/src/k8s.io/apimachinery/pkg/api/validate/content/kube.go#L24-L72
+	//
+	// Supported effects: NoSchedule, PreferNoSchedule, NoExecute.
+	// Caution: NoExecute evicts existing pods and can cause significant disruption
+	// when combined with continuous enforcement mode. Prefer NoSchedule for most use cases.
+	Taint *v1.TaintApplyConfiguration `json:"taint,omitempty"`
+	// nodeSelector limits the scope of this rule to a specific subset of Nodes.
+	NodeSelector *metav1.LabelSelectorApplyConfiguration `json:"nodeSelector,omitempty"`
+	// conditionPolicy controls how the conditions list is evaluated.
+	// "allOf" (default) requires every condition to match its requiredStatus before the taint is removed.
+	// "anyOf" requires at least one condition to match its requiredStatus.
+	//
+	// anyOf cannot be used with enforcementMode: bootstrap-only.
+	ConditionPolicy *apiv1alpha1.ConditionPolicy `json:"conditionPolicy,omitempty"`
+	// dryRun when set to true, The controller will evaluate Node conditions and log intended taint modifications
+	// without persisting changes to the cluster. Proposed actions are reflected in the resource status.
+	DryRun *bool `json:"dryRun,omitempty"`
+	// nodeScope restricts the rule to recently created Nodes, so that
+	// creating a rule does not taint Nodes that have been running for a long
+	// time, e.g. because they never reported a condition the rule requires.
+	// Nodes outside the scope are marked as having completed bootstrap
+	// without being evaluated. When omitted, the rule applies to all Nodes
+	// matching nodeSelector.
+	//
+	// nodeScope can only be used with enforcementMode: bootstrap-only.
+	NodeScope *NodeScopeApplyConfiguration `json:"nodeScope,omitempty"`
+	// bootstrapRearmTriggers lists the events that make the rule bootstrap a
+	// Node again once it has completed bootstrap. When one of them happens,
+	// the bootstrap completion annotation is dropped, the taint is applied
+	// again and is removed once the conditions are met, as on the first
+	// bootstrap.
+	// Each trigger is one of NodeReboot, KubeletUpgrade, Annotation.
+	// "NodeReboot" re-arms when status.nodeInfo.bootID changes.
+	// "KubeletUpgrade" re-arms when status.nodeInfo.kubeletVersion changes.
+	// "Annotation" re-arms when the Node is annotated with
+	// readiness.k8s.io/rearm-bootstrap; the controller removes the annotation
+	// once all rules have seen it.
+	//
+	// bootstrapRearmTriggers can only be used with enforcementMode: bootstrap-only.
+	BootstrapRearmTriggers []apiv1alpha1.BootstrapRearmTrigger `json:"bootstrapRearmTriggers,omitempty"`
+	// bootstrapID is a stable identity for the rule's bootstrap completions
+	// that survives the rule being recreated, e.g. by a backup restore or a
+	// GitOps delete-and-recreate, which gives the rule a new UID. Nodes that
+	// completed bootstrap for a previous rule with the same bootstrapID are
+	// recognised as completed instead of being tainted again, and their
+	// completion annotation is migrated to the new UID.
+	// Completion annotations of a deleted rule with a bootstrapID are kept,
+	// so that the recreated rule can adopt them, until the background sweep
+	// finds no rule claiming them.
+	// bootstrapID must be unique among rules that are not being deleted.
+	//
+	// bootstrapID can only be used with enforcementMode: bootstrap-only.
+	BootstrapID *string `json:"bootstrapID,omitempty"`
+	// bootstrapAdoptionPolicy controls which bootstrap completions recorded
+	// by previous rules the rule adopts.
+	// bootstrapAdoptionPolicy is one of None, SameRuleName.
+	// "None" (default) only trusts completions recorded under the rule's own
+	// UID or, when set, its bootstrapID.
+	// "SameRuleName" also trusts completions recorded by a previous rule with
+	// the same name, which allows rules created before bootstrapID was set to
+	// be recreated safely. Completions are migrated to the new UID, and those
+	// of a deleted rule are kept until the background sweep finds no rule
+	// claiming them.
+	//
+	// bootstrapAdoptionPolicy can only be used with enforcementMode: bootstrap-only.
+	BootstrapAdoptionPolicy *apiv1alpha1.BootstrapAdoptionPolicy `json:"bootstrapAdoptionPolicy,omitempty"`
+	// flapDetection quarantines Nodes whose conditions keep flipping between
+	// satisfied and unsatisfied. A quarantined Node keeps the taint until it
+	// has been stable for the cooldown period, or until an operator clears the
+	// quarantine by annotating the Node with readiness.k8s.io/clear-quarantine.
+	//
+	// flapDetection cannot be used with enforcementMode: bootstrap-only.
+	FlapDetection *FlapDetectionApplyConfiguration `json:"flapDetection,omitempty"`
+	// taintEscalation escalates the effect of the taint the longer a Node
+	// stays unready. The taint is first applied with the effect from taint,
+	// and its effect is swapped in place for the effect of each step once
+	// the taint has been present for that step's afterSeconds.
+	//
+	// Steps must be ordered by increasing afterSeconds and each step's effect
+	// must be more restrictive than the previous one, from PreferNoSchedule
+	// through NoSchedule to NoExecute.
+	//
+	// taintEscalation cannot be used with enforcementMode: bootstrap-only.
+	TaintEscalation []TaintEscalationStepApplyConfiguration `json:"taintEscalation,omitempty"`
+	// taintAdoptionPolicy controls what the rule does when it first evaluates
+	// a Node that already carries its taint, e.g. applied by the Node's
+	// provisioner or left behind by a previous rule.
+	// taintAdoptionPolicy is one of Adopt, Refuse, Replace.
+	// "Adopt" (default) takes over the taint as is, including its value and
+	// the time it was added.
+	// "Refuse" leaves the Node and its taints untouched, records the refusal
+	// in the Node's status and emits a Warning event. The rule manages the
+	// Node once the taint has been removed.
+	// "Replace" removes the existing taint and, unless the Node is ready,
+	// applies the rule's taint afresh in the same update.
+	TaintAdoptionPolicy *apiv1alpha1.TaintAdoptionPolicy `json:"taintAdoptionPolicy,omitempty"`
+	// nodeExitPolicy controls what happens when a Node the rule has evaluated
+	// stops matching nodeSelector, e.g. because its labels changed.
+	// nodeExitPolicy is one of RemoveTaint, KeepTaint.
+	// "RemoveTaint" (default) removes the rule's taint from the Node, unless
+	// another rule matching the Node manages the same taint, and drops the
+	// Node from the rule's status.
+	// "KeepTaint" leaves the taint and the Node's status entry in place.
+	NodeExitPolicy *apiv1alpha1.NodeExitPolicy `json:"nodeExitPolicy,omitempty"`
+	// deletionPolicy controls what happens to the rule's taint when the rule
+	// is deleted.
+	// deletionPolicy is one of Delete, Retain, OrphanToRule.
+	// "Delete" (default) removes the taint from every Node the rule selects.
+	// "Retain" leaves the taint on every Node and only removes the finalizer.
+	// "OrphanToRule" hands the taint over to the rule named by
+	// successorRuleName, which must manage the same taint key and effect.
+	// Deletion waits until the successor exists; the taint is then left on
+	// the Nodes the successor selects and removed from all others.
+	DeletionPolicy *apiv1alpha1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+	// successorRuleName is the name of the rule that takes over the taint
+	// when deletionPolicy is OrphanToRule. It must be set if and only if
+	// deletionPolicy is OrphanToRule.
+	SuccessorRuleName *string `json:"successorRuleName,omitempty"`
+	// rollout enforces the rule progressively, on a growing fraction of the
+	// Nodes matching nodeSelector. Nodes are picked by a stable hash of the
+	// rule and Node names, so that each stage enforces the rule on a superset
+	// of the Nodes of the previous one. The remaining Nodes are accounted for
+	// in dryRunResults. When omitted, the rule is enforced on all Nodes.
+	//
+	// rollout cannot be used with enforcementMode: bootstrap-only.
+	Rollout *RolloutApplyConfiguration `json:"rollout,omitempty"`
+	// schedule limits when the rule is in effect, e.g. to the duration of a
+	// maintenance campaign. Outside its activation windows the rule is
+	// suspended: Nodes are not evaluated and taints are neither added nor
+	// removed. Once expiresAt has passed, the rule is deleted and its taints
+	// are cleaned up according to deletionPolicy.
+	Schedule *RuleScheduleApplyConfiguration `json:"schedule,omitempty"`
+	// drain evicts Pods that do not tolerate the taint from Nodes that stay
+	// unready, through the Eviction API so that PodDisruptionBudgets are
+	// respected. Unlike a NoExecute taint, evictions that would violate a
+	// PodDisruptionBudget are retried later instead of being forced.
+	// DaemonSet and mirror Pods are never evicted.
+	//
+	// drain cannot be used with enforcementMode: bootstrap-only.
+	Drain *DrainApplyConfiguration `json:"drain,omitempty"`
+}
+
+// NodeReadinessRuleSpecApplyConfiguration constructs a declarative configuration of the NodeReadinessRuleSpec type for use with
+// apply.
+func NodeReadinessRuleSpec() *NodeReadinessRuleSpecApplyConfiguration {
+	return &NodeReadinessRuleSpecApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithConditions(values ...*ConditionRequirementApplyConfiguration) *NodeReadinessRuleSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithEnforcementMode sets the EnforcementMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EnforcementMode field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithEnforcementMode(value apiv1alpha1.EnforcementMode) *NodeReadinessRuleSpecApplyConfiguration {
+	b.EnforcementMode = &value
+	return b
+}
+
+// WithTaint sets the Taint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Taint field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithTaint(value *v1.TaintApplyConfiguration) *NodeReadinessRuleSpecApplyConfiguration {
+	b.Taint = value
+	return b
+}
+
+// WithNodeSelector sets the NodeSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeSelector field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithNodeSelector(value *metav1.LabelSelectorApplyConfiguration) *NodeReadinessRuleSpecApplyConfiguration {
+	b.NodeSelector = value
+	return b
+}
+
+// WithConditionPolicy sets the ConditionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConditionPolicy field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithConditionPolicy(value apiv1alpha1.ConditionPolicy) *NodeReadinessRuleSpecApplyConfiguration {
+	b.ConditionPolicy = &value
+	return b
+}
+
+// WithDryRun sets the DryRun field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DryRun field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithDryRun(value bool) *NodeReadinessRuleSpecApplyConfiguration {
+	b.DryRun = &value
+	return b
+}
+
+// WithNodeScope sets the NodeScope field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeScope field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithNodeScope(value *NodeScopeApplyConfiguration) *NodeReadinessRuleSpecApplyConfiguration {
+	b.NodeScope = value
+	return b
+}
+
+// WithBootstrapRearmTriggers adds the given value to the BootstrapRearmTriggers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the BootstrapRearmTriggers field.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithBootstrapRearmTriggers(values ...apiv1alpha1.BootstrapRearmTrigger) *NodeReadinessRuleSpecApplyConfiguration {
+	for i := range values {
+		b.BootstrapRearmTriggers = append(b.BootstrapRearmTriggers, values[i])
+	}
+	return b
+}
+
+// WithBootstrapID sets the BootstrapID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BootstrapID field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithBootstrapID(value string) *NodeReadinessRuleSpecApplyConfiguration {
+	b.BootstrapID = &value
+	return b
+}
+
+// WithBootstrapAdoptionPolicy sets the BootstrapAdoptionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BootstrapAdoptionPolicy field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithBootstrapAdoptionPolicy(value apiv1alpha1.BootstrapAdoptionPolicy) *NodeReadinessRuleSpecApplyConfiguration {
+	b.BootstrapAdoptionPolicy = &value
+	return b
+}
+
+// WithFlapDetection sets the FlapDetection field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FlapDetection field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithFlapDetection(value *FlapDetectionApplyConfiguration) *NodeReadinessRuleSpecApplyConfiguration {
+	b.FlapDetection = value
+	return b
+}
+
+// WithTaintEscalation adds the given value to the TaintEscalation field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TaintEscalation field.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithTaintEscalation(values ...*TaintEscalationStepApplyConfiguration) *NodeReadinessRuleSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTaintEscalation")
+		}
+		b.TaintEscalation = append(b.TaintEscalation, *values[i])
+	}
+	return b
+}
+
+// WithTaintAdoptionPolicy sets the TaintAdoptionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TaintAdoptionPolicy field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithTaintAdoptionPolicy(value apiv1alpha1.TaintAdoptionPolicy) *NodeReadinessRuleSpecApplyConfiguration {
+	b.TaintAdoptionPolicy = &value
+	return b
+}
+
+// WithNodeExitPolicy sets the NodeExitPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeExitPolicy field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithNodeExitPolicy(value apiv1alpha1.NodeExitPolicy) *NodeReadinessRuleSpecApplyConfiguration {
+	b.NodeExitPolicy = &value
+	return b
+}
+
+// WithDeletionPolicy sets the DeletionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionPolicy field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithDeletionPolicy(value apiv1alpha1.DeletionPolicy) *NodeReadinessRuleSpecApplyConfiguration {
+	b.DeletionPolicy = &value
+	return b
+}
+
+// WithSuccessorRuleName sets the SuccessorRuleName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuccessorRuleName field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithSuccessorRuleName(value string) *NodeReadinessRuleSpecApplyConfiguration {
+	b.SuccessorRuleName = &value
+	return b
+}
+
+// WithRollout sets the Rollout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rollout field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithRollout(value *RolloutApplyConfiguration) *NodeReadinessRuleSpecApplyConfiguration {
+	b.Rollout = value
+	return b
+}
+
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithSchedule(value *RuleScheduleApplyConfiguration) *NodeReadinessRuleSpecApplyConfiguration {
+	b.Schedule = value
+	return b
+}
+
+// WithDrain sets the Drain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Drain field is set to the value of the last call.
+func (b *NodeReadinessRuleSpecApplyConfiguration) WithDrain(value *DrainApplyConfiguration) *NodeReadinessRuleSpecApplyConfiguration {
+	b.Drain = value
+	return b
+}
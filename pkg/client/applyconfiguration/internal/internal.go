@@ -0,0 +1,1261 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package internal
+
+import (
+	fmt "fmt"
+	sync "sync"
+
+	typed "sigs.k8s.io/structured-merge-diff/v6/typed"
+)
+
+func Parser() *typed.Parser {
+	parserOnce.Do(func() {
+		var err error
+		parser, err = typed.NewParser(schemaYAML)
+		if err != nil {
+			panic(fmt.Sprintf("Failed to parse schema: %v", err))
+		}
+	})
+	return parser
+}
+
+var parserOnce sync.Once
+var parser *typed.Parser
+var schemaYAML = typed.YAMLObject(`types:
+- name: io.k8s.api.core.v1.Taint
+  map:
+    fields:
+    - name: effect
+      type:
+        scalar: string
+      default: ""
+    - name: key
+      type:
+        scalar: string
+      default: ""
+    - name: timeAdded
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: value
+      type:
+        scalar: string
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+  map:
+    fields:
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+      default: ""
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: reason
+      type:
+        scalar: string
+      default: ""
+    - name: status
+      type:
+        scalar: string
+      default: ""
+    - name: type
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.FieldsV1
+  map:
+    elementType:
+      scalar: untyped
+      list:
+        elementType:
+          namedType: __untyped_atomic_
+        elementRelationship: atomic
+      map:
+        elementType:
+          namedType: __untyped_deduced_
+        elementRelationship: separable
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+  map:
+    fields:
+    - name: matchExpressions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelectorRequirement
+          elementRelationship: atomic
+    - name: matchLabels
+      type:
+        map:
+          elementType:
+            scalar: string
+    elementRelationship: atomic
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelectorRequirement
+  map:
+    fields:
+    - name: key
+      type:
+        scalar: string
+      default: ""
+    - name: operator
+      type:
+        scalar: string
+      default: ""
+    - name: values
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.ManagedFieldsEntry
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: fieldsType
+      type:
+        scalar: string
+    - name: fieldsV1
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.FieldsV1
+    - name: manager
+      type:
+        scalar: string
+    - name: operation
+      type:
+        scalar: string
+    - name: subresource
+      type:
+        scalar: string
+    - name: time
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+  map:
+    fields:
+    - name: annotations
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: creationTimestamp
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: deletionGracePeriodSeconds
+      type:
+        scalar: numeric
+    - name: deletionTimestamp
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: finalizers
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: generateName
+      type:
+        scalar: string
+    - name: generation
+      type:
+        scalar: numeric
+    - name: labels
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: managedFields
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ManagedFieldsEntry
+          elementRelationship: atomic
+    - name: name
+      type:
+        scalar: string
+    - name: namespace
+      type:
+        scalar: string
+    - name: ownerReferences
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.OwnerReference
+          elementRelationship: associative
+          keys:
+          - uid
+    - name: resourceVersion
+      type:
+        scalar: string
+    - name: selfLink
+      type:
+        scalar: string
+    - name: uid
+      type:
+        scalar: string
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.OwnerReference
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+      default: ""
+    - name: blockOwnerDeletion
+      type:
+        scalar: boolean
+    - name: controller
+      type:
+        scalar: boolean
+    - name: kind
+      type:
+        scalar: string
+      default: ""
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: uid
+      type:
+        scalar: string
+      default: ""
+    elementRelationship: atomic
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+  scalar: untyped
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.ActivationWindow
+  map:
+    fields:
+    - name: durationSeconds
+      type:
+        scalar: numeric
+    - name: start
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.ConditionEvaluationResult
+  map:
+    fields:
+    - name: currentStatus
+      type:
+        scalar: string
+    - name: defaultStatus
+      type:
+        scalar: string
+    - name: requiredStatus
+      type:
+        scalar: string
+    - name: type
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.ConditionRequirement
+  map:
+    fields:
+    - name: defaultStatus
+      type:
+        scalar: string
+    - name: requiredStatus
+      type:
+        scalar: string
+    - name: type
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.Drain
+  map:
+    fields:
+    - name: afterSeconds
+      type:
+        scalar: numeric
+    - name: maxEvictionsPerMinute
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.DryRunNode
+  map:
+    fields:
+    - name: action
+      type:
+        scalar: string
+    - name: failingConditions
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: missingConditions
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: nodeName
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.DryRunResults
+  map:
+    fields:
+    - name: affectedNodes
+      type:
+        scalar: numeric
+    - name: nodes
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.DryRunNode
+          elementRelationship: associative
+          keys:
+          - nodeName
+    - name: outOfScopeNodes
+      type:
+        scalar: numeric
+    - name: riskyOperations
+      type:
+        scalar: numeric
+    - name: summary
+      type:
+        scalar: string
+    - name: taintsToAdd
+      type:
+        scalar: numeric
+    - name: taintsToEscalate
+      type:
+        scalar: numeric
+    - name: taintsToRemove
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.FlapDetection
+  map:
+    fields:
+    - name: cooldownSeconds
+      type:
+        scalar: numeric
+    - name: transitionThreshold
+      type:
+        scalar: numeric
+    - name: windowSeconds
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.FlapState
+  map:
+    fields:
+    - name: lastResult
+      type:
+        scalar: string
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: quarantineStartTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: transitions
+      type:
+        scalar: numeric
+    - name: windowStartTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeDrainStatus
+  map:
+    fields:
+    - name: message
+      type:
+        scalar: string
+    - name: phase
+      type:
+        scalar: string
+    - name: podsEvicted
+      type:
+        scalar: numeric
+    - name: podsRemaining
+      type:
+        scalar: numeric
+    - name: startTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeEvaluation
+  map:
+    fields:
+    - name: conditionResults
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.ConditionEvaluationResult
+          elementRelationship: associative
+          keys:
+          - type
+    - name: drain
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeDrainStatus
+      default: {}
+    - name: escalationStartTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: flap
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.FlapState
+      default: {}
+    - name: lastEvaluationTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: nodeName
+      type:
+        scalar: string
+    - name: override
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeOverride
+      default: {}
+    - name: taintAddedTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: taintAdoption
+      type:
+        scalar: string
+    - name: taintStatus
+      type:
+        scalar: string
+    - name: transitions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeTransition
+          elementRelationship: atomic
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeFailure
+  map:
+    fields:
+    - name: lastEvaluationTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+    - name: nodeName
+      type:
+        scalar: string
+    - name: reason
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeOverride
+  map:
+    fields:
+    - name: action
+      type:
+        scalar: string
+    - name: annotation
+      type:
+        scalar: string
+    - name: expirationTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeReadinessRule
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeReadinessRuleSpec
+      default: {}
+    - name: status
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeReadinessRuleStatus
+      default: {}
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeReadinessRuleSpec
+  map:
+    fields:
+    - name: bootstrapAdoptionPolicy
+      type:
+        scalar: string
+    - name: bootstrapID
+      type:
+        scalar: string
+    - name: bootstrapRearmTriggers
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: conditionPolicy
+      type:
+        scalar: string
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.ConditionRequirement
+          elementRelationship: associative
+          keys:
+          - type
+    - name: deletionPolicy
+      type:
+        scalar: string
+    - name: drain
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.Drain
+      default: {}
+    - name: dryRun
+      type:
+        scalar: boolean
+    - name: enforcementMode
+      type:
+        scalar: string
+    - name: flapDetection
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.FlapDetection
+      default: {}
+    - name: nodeExitPolicy
+      type:
+        scalar: string
+    - name: nodeScope
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeScope
+      default: {}
+    - name: nodeSelector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+      default: {}
+    - name: rollout
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.Rollout
+      default: {}
+    - name: schedule
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.RuleSchedule
+      default: {}
+    - name: successorRuleName
+      type:
+        scalar: string
+    - name: taint
+      type:
+        namedType: io.k8s.api.core.v1.Taint
+      default: {}
+    - name: taintAdoptionPolicy
+      type:
+        scalar: string
+    - name: taintEscalation
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.TaintEscalationStep
+          elementRelationship: atomic
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeReadinessRuleStatus
+  map:
+    fields:
+    - name: appliedNodes
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: associative
+          keys:
+          - type
+    - name: dryRunResults
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.DryRunResults
+      default: {}
+    - name: dryRunStartTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: failedNodes
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeFailure
+          elementRelationship: associative
+          keys:
+          - nodeName
+    - name: nextTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: nodeCounts
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.RuleNodeCounts
+      default: {}
+    - name: nodeEvaluations
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeEvaluation
+          elementRelationship: associative
+          keys:
+          - nodeName
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: rollout
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.RolloutStatus
+      default: {}
+    - name: scheduleState
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeScope
+  map:
+    fields:
+    - name: maxAgeSeconds
+      type:
+        scalar: numeric
+    - name: type
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.NodeTransition
+  map:
+    fields:
+    - name: conditionStatus
+      type:
+        scalar: string
+    - name: conditionType
+      type:
+        scalar: string
+    - name: message
+      type:
+        scalar: string
+    - name: reason
+      type:
+        scalar: string
+    - name: time
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: type
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.Rollout
+  map:
+    fields:
+    - name: maxFailurePercent
+      type:
+        scalar: numeric
+    - name: maxNewlyTaintedNodes
+      type:
+        scalar: numeric
+    - name: stages
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.RolloutStage
+          elementRelationship: atomic
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.RolloutStage
+  map:
+    fields:
+    - name: pauseSeconds
+      type:
+        scalar: numeric
+    - name: percent
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.RolloutStatus
+  map:
+    fields:
+    - name: currentStage
+      type:
+        scalar: numeric
+    - name: enforcedNodes
+      type:
+        scalar: numeric
+    - name: failingNodes
+      type:
+        scalar: numeric
+    - name: message
+      type:
+        scalar: string
+    - name: newlyTaintedNodes
+      type:
+        scalar: numeric
+    - name: percent
+      type:
+        scalar: numeric
+    - name: phase
+      type:
+        scalar: string
+    - name: stageStartTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.RuleNodeCounts
+  map:
+    fields:
+    - name: bootstrapCompleted
+      type:
+        scalar: numeric
+    - name: failed
+      type:
+        scalar: numeric
+    - name: held
+      type:
+        scalar: numeric
+    - name: matched
+      type:
+        scalar: numeric
+    - name: released
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.RuleSchedule
+  map:
+    fields:
+    - name: activationWindows
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1alpha1.ActivationWindow
+          elementRelationship: atomic
+    - name: expiresAt
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: timeZone
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1alpha1.TaintEscalationStep
+  map:
+    fields:
+    - name: afterSeconds
+      type:
+        scalar: numeric
+    - name: effect
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.ActivationWindow
+  map:
+    fields:
+    - name: durationSeconds
+      type:
+        scalar: numeric
+    - name: start
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.ConditionEvaluationResult
+  map:
+    fields:
+    - name: currentStatus
+      type:
+        scalar: string
+    - name: defaultStatus
+      type:
+        scalar: string
+    - name: requiredStatus
+      type:
+        scalar: string
+    - name: type
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.ConditionRequirement
+  map:
+    fields:
+    - name: defaultStatus
+      type:
+        scalar: string
+    - name: requiredStatus
+      type:
+        scalar: string
+    - name: type
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.Drain
+  map:
+    fields:
+    - name: afterSeconds
+      type:
+        scalar: numeric
+    - name: maxEvictionsPerMinute
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.DryRunNode
+  map:
+    fields:
+    - name: action
+      type:
+        scalar: string
+    - name: failingConditions
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: missingConditions
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: nodeName
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.DryRunResults
+  map:
+    fields:
+    - name: affectedNodes
+      type:
+        scalar: numeric
+    - name: nodes
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.DryRunNode
+          elementRelationship: associative
+          keys:
+          - nodeName
+    - name: outOfScopeNodes
+      type:
+        scalar: numeric
+    - name: riskyOperations
+      type:
+        scalar: numeric
+    - name: summary
+      type:
+        scalar: string
+    - name: taintsToAdd
+      type:
+        scalar: numeric
+    - name: taintsToEscalate
+      type:
+        scalar: numeric
+    - name: taintsToRemove
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.FlapDetection
+  map:
+    fields:
+    - name: cooldownSeconds
+      type:
+        scalar: numeric
+    - name: transitionThreshold
+      type:
+        scalar: numeric
+    - name: windowSeconds
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.FlapState
+  map:
+    fields:
+    - name: lastResult
+      type:
+        scalar: string
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: quarantineStartTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: transitions
+      type:
+        scalar: numeric
+    - name: windowStartTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeDrainStatus
+  map:
+    fields:
+    - name: message
+      type:
+        scalar: string
+    - name: phase
+      type:
+        scalar: string
+    - name: podsEvicted
+      type:
+        scalar: numeric
+    - name: podsRemaining
+      type:
+        scalar: numeric
+    - name: startTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeEvaluation
+  map:
+    fields:
+    - name: conditionResults
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.ConditionEvaluationResult
+          elementRelationship: associative
+          keys:
+          - type
+    - name: drain
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeDrainStatus
+      default: {}
+    - name: escalationStartTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: flap
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.FlapState
+      default: {}
+    - name: lastEvaluationTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: nodeName
+      type:
+        scalar: string
+    - name: override
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeOverride
+      default: {}
+    - name: taintAddedTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: taintAdoption
+      type:
+        scalar: string
+    - name: taintStatus
+      type:
+        scalar: string
+    - name: transitions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeTransition
+          elementRelationship: atomic
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeFailure
+  map:
+    fields:
+    - name: lastEvaluationTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+    - name: nodeName
+      type:
+        scalar: string
+    - name: reason
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeOverride
+  map:
+    fields:
+    - name: action
+      type:
+        scalar: string
+    - name: annotation
+      type:
+        scalar: string
+    - name: expirationTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeReadinessRule
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeReadinessRuleSpec
+      default: {}
+    - name: status
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeReadinessRuleStatus
+      default: {}
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeReadinessRuleSpec
+  map:
+    fields:
+    - name: bootstrapAdoptionPolicy
+      type:
+        scalar: string
+    - name: bootstrapID
+      type:
+        scalar: string
+    - name: bootstrapRearmTriggers
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: conditionPolicy
+      type:
+        scalar: string
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.ConditionRequirement
+          elementRelationship: associative
+          keys:
+          - type
+    - name: deletionPolicy
+      type:
+        scalar: string
+    - name: drain
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.Drain
+      default: {}
+    - name: dryRun
+      type:
+        scalar: boolean
+    - name: enforcementMode
+      type:
+        scalar: string
+    - name: flapDetection
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.FlapDetection
+      default: {}
+    - name: nodeExitPolicy
+      type:
+        scalar: string
+    - name: nodeScope
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeScope
+      default: {}
+    - name: nodeSelector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+      default: {}
+    - name: rollout
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.Rollout
+      default: {}
+    - name: schedule
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.RuleSchedule
+      default: {}
+    - name: successorRuleName
+      type:
+        scalar: string
+    - name: taint
+      type:
+        namedType: io.k8s.api.core.v1.Taint
+      default: {}
+    - name: taintAdoptionPolicy
+      type:
+        scalar: string
+    - name: taintEscalation
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.TaintEscalationStep
+          elementRelationship: atomic
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeReadinessRuleStatus
+  map:
+    fields:
+    - name: appliedNodes
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: associative
+          keys:
+          - type
+    - name: dryRunResults
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.DryRunResults
+      default: {}
+    - name: dryRunStartTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: failedNodes
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeFailure
+          elementRelationship: associative
+          keys:
+          - nodeName
+    - name: nextTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: nodeCounts
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.RuleNodeCounts
+      default: {}
+    - name: nodeEvaluations
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeEvaluation
+          elementRelationship: associative
+          keys:
+          - nodeName
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: rollout
+      type:
+        namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.RolloutStatus
+      default: {}
+    - name: scheduleState
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeScope
+  map:
+    fields:
+    - name: maxAgeSeconds
+      type:
+        scalar: numeric
+    - name: type
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.NodeTransition
+  map:
+    fields:
+    - name: conditionStatus
+      type:
+        scalar: string
+    - name: conditionType
+      type:
+        scalar: string
+    - name: message
+      type:
+        scalar: string
+    - name: reason
+      type:
+        scalar: string
+    - name: time
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: type
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.Rollout
+  map:
+    fields:
+    - name: maxFailurePercent
+      type:
+        scalar: numeric
+    - name: maxNewlyTaintedNodes
+      type:
+        scalar: numeric
+    - name: stages
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.RolloutStage
+          elementRelationship: atomic
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.RolloutStage
+  map:
+    fields:
+    - name: pauseSeconds
+      type:
+        scalar: numeric
+    - name: percent
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.RolloutStatus
+  map:
+    fields:
+    - name: currentStage
+      type:
+        scalar: numeric
+    - name: enforcedNodes
+      type:
+        scalar: numeric
+    - name: failingNodes
+      type:
+        scalar: numeric
+    - name: message
+      type:
+        scalar: string
+    - name: newlyTaintedNodes
+      type:
+        scalar: numeric
+    - name: percent
+      type:
+        scalar: numeric
+    - name: phase
+      type:
+        scalar: string
+    - name: stageStartTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.RuleNodeCounts
+  map:
+    fields:
+    - name: bootstrapCompleted
+      type:
+        scalar: numeric
+    - name: failed
+      type:
+        scalar: numeric
+    - name: held
+      type:
+        scalar: numeric
+    - name: matched
+      type:
+        scalar: numeric
+    - name: released
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.RuleSchedule
+  map:
+    fields:
+    - name: activationWindows
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.node-readiness-controller.api.v1beta1.ActivationWindow
+          elementRelationship: atomic
+    - name: expiresAt
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: timeZone
+      type:
+        scalar: string
+- name: io.k8s.sigs.node-readiness-controller.api.v1beta1.TaintEscalationStep
+  map:
+    fields:
+    - name: afterSeconds
+      type:
+        scalar: numeric
+    - name: effect
+      type:
+        scalar: string
+- name: __untyped_atomic_
+  scalar: untyped
+  list:
+    elementType:
+      namedType: __untyped_atomic_
+    elementRelationship: atomic
+  map:
+    elementType:
+      namedType: __untyped_atomic_
+    elementRelationship: atomic
+- name: __untyped_deduced_
+  scalar: untyped
+  list:
+    elementType:
+      namedType: __untyped_atomic_
+    elementRelationship: atomic
+  map:
+    elementType:
+      namedType: __untyped_deduced_
+    elementRelationship: separable
+`)
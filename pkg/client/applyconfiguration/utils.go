@@ -0,0 +1,138 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
+	v1alpha1 "sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	v1beta1 "sigs.k8s.io/node-readiness-controller/api/v1beta1"
+	apiv1alpha1 "sigs.k8s.io/node-readiness-controller/pkg/client/applyconfiguration/api/v1alpha1"
+	apiv1beta1 "sigs.k8s.io/node-readiness-controller/pkg/client/applyconfiguration/api/v1beta1"
+	internal "sigs.k8s.io/node-readiness-controller/pkg/client/applyconfiguration/internal"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=readiness.node.x-k8s.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("ActivationWindow"):
+		return &apiv1alpha1.ActivationWindowApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ConditionEvaluationResult"):
+		return &apiv1alpha1.ConditionEvaluationResultApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ConditionRequirement"):
+		return &apiv1alpha1.ConditionRequirementApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Drain"):
+		return &apiv1alpha1.DrainApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DryRunNode"):
+		return &apiv1alpha1.DryRunNodeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DryRunResults"):
+		return &apiv1alpha1.DryRunResultsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FlapDetection"):
+		return &apiv1alpha1.FlapDetectionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FlapState"):
+		return &apiv1alpha1.FlapStateApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeDrainStatus"):
+		return &apiv1alpha1.NodeDrainStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeEvaluation"):
+		return &apiv1alpha1.NodeEvaluationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeFailure"):
+		return &apiv1alpha1.NodeFailureApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeOverride"):
+		return &apiv1alpha1.NodeOverrideApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeReadinessRule"):
+		return &apiv1alpha1.NodeReadinessRuleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeReadinessRuleSpec"):
+		return &apiv1alpha1.NodeReadinessRuleSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeReadinessRuleStatus"):
+		return &apiv1alpha1.NodeReadinessRuleStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeScope"):
+		return &apiv1alpha1.NodeScopeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeTransition"):
+		return &apiv1alpha1.NodeTransitionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Rollout"):
+		return &apiv1alpha1.RolloutApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RolloutStage"):
+		return &apiv1alpha1.RolloutStageApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RolloutStatus"):
+		return &apiv1alpha1.RolloutStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RuleNodeCounts"):
+		return &apiv1alpha1.RuleNodeCountsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RuleSchedule"):
+		return &apiv1alpha1.RuleScheduleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TaintEscalationStep"):
+		return &apiv1alpha1.TaintEscalationStepApplyConfiguration{}
+
+		// Group=readiness.node.x-k8s.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("ActivationWindow"):
+		return &apiv1beta1.ActivationWindowApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ConditionEvaluationResult"):
+		return &apiv1beta1.ConditionEvaluationResultApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ConditionRequirement"):
+		return &apiv1beta1.ConditionRequirementApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Drain"):
+		return &apiv1beta1.DrainApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DryRunNode"):
+		return &apiv1beta1.DryRunNodeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DryRunResults"):
+		return &apiv1beta1.DryRunResultsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("FlapDetection"):
+		return &apiv1beta1.FlapDetectionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("FlapState"):
+		return &apiv1beta1.FlapStateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NodeDrainStatus"):
+		return &apiv1beta1.NodeDrainStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NodeEvaluation"):
+		return &apiv1beta1.NodeEvaluationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NodeFailure"):
+		return &apiv1beta1.NodeFailureApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NodeOverride"):
+		return &apiv1beta1.NodeOverrideApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NodeReadinessRule"):
+		return &apiv1beta1.NodeReadinessRuleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NodeReadinessRuleSpec"):
+		return &apiv1beta1.NodeReadinessRuleSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NodeReadinessRuleStatus"):
+		return &apiv1beta1.NodeReadinessRuleStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NodeScope"):
+		return &apiv1beta1.NodeScopeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NodeTransition"):
+		return &apiv1beta1.NodeTransitionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Rollout"):
+		return &apiv1beta1.RolloutApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RolloutStage"):
+		return &apiv1beta1.RolloutStageApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RolloutStatus"):
+		return &apiv1beta1.RolloutStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RuleNodeCounts"):
+		return &apiv1beta1.RuleNodeCountsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RuleSchedule"):
+		return &apiv1beta1.RuleScheduleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaintEscalationStep"):
+		return &apiv1beta1.TaintEscalationStepApplyConfiguration{}
+
+	}
+	return nil
+}
+
+func NewTypeConverter(scheme *runtime.Scheme) managedfields.TypeConverter {
+	return managedfields.NewSchemeTypeConverter(scheme, internal.Parser())
+}
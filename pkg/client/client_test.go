@@ -0,0 +1,134 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+
+	"sigs.k8s.io/node-readiness-controller/api/v1alpha1"
+	applyv1alpha1 "sigs.k8s.io/node-readiness-controller/pkg/client/applyconfiguration/api/v1alpha1"
+	"sigs.k8s.io/node-readiness-controller/pkg/client/clientset/versioned/fake"
+	"sigs.k8s.io/node-readiness-controller/pkg/client/informers/externalversions"
+)
+
+func newRule(name string) *v1alpha1.NodeReadinessRule {
+	return &v1alpha1.NodeReadinessRule{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.NodeReadinessRuleSpec{
+			Conditions: []v1alpha1.ConditionRequirement{
+				{Type: "example.com/NetworkReady", RequiredStatus: corev1.ConditionTrue},
+			},
+			Taint: corev1.Taint{
+				Key:    "readiness.k8s.io/example.com/network-unavailable",
+				Effect: corev1.TaintEffectNoSchedule,
+			},
+			EnforcementMode: v1alpha1.EnforcementModeBootstrapOnly,
+		},
+	}
+}
+
+func TestFakeClientsetCRUD(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	client := fake.NewClientset(newRule("existing"))
+	rules := client.ReadinessV1alpha1().NodeReadinessRules()
+
+	got, err := rules.Get(ctx, "existing", metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.Spec.Taint.Key).To(Equal("readiness.k8s.io/example.com/network-unavailable"))
+
+	_, err = rules.Create(ctx, newRule("created"), metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	got.Status.ObservedGeneration = 1
+	_, err = rules.UpdateStatus(ctx, got, metav1.UpdateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	list, err := rules.List(ctx, metav1.ListOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(list.Items).To(HaveLen(2))
+
+	g.Expect(rules.Delete(ctx, "created", metav1.DeleteOptions{})).To(Succeed())
+	list, err = rules.List(ctx, metav1.ListOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(list.Items).To(HaveLen(1))
+	g.Expect(list.Items[0].Status.ObservedGeneration).To(Equal(int64(1)))
+}
+
+func TestFakeClientsetApply(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	client := fake.NewClientset()
+	rules := client.ReadinessV1alpha1().NodeReadinessRules()
+
+	rule := applyv1alpha1.NodeReadinessRule("applied").
+		WithSpec(applyv1alpha1.NodeReadinessRuleSpec().
+			WithConditions(applyv1alpha1.ConditionRequirement().
+				WithType("example.com/NetworkReady").
+				WithRequiredStatus(corev1.ConditionTrue)).
+			WithTaint(applycorev1.Taint().
+				WithKey("readiness.k8s.io/example.com/network-unavailable").
+				WithEffect(corev1.TaintEffectNoSchedule)).
+			WithEnforcementMode(v1alpha1.EnforcementModeContinuous))
+	got, err := rules.Apply(ctx, rule, metav1.ApplyOptions{FieldManager: "test", Force: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.Spec.EnforcementMode).To(Equal(v1alpha1.EnforcementModeContinuous))
+	g.Expect(got.Spec.Conditions).To(HaveLen(1))
+
+	rule.Spec.WithDryRun(true)
+	got, err = rules.Apply(ctx, rule, metav1.ApplyOptions{FieldManager: "test", Force: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.Spec.DryRun).To(BeTrue())
+	g.Expect(got.Spec.EnforcementMode).To(Equal(v1alpha1.EnforcementModeContinuous))
+
+	extracted, err := applyv1alpha1.ExtractNodeReadinessRule(got, "test")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(extracted).To(Equal(rule))
+}
+
+func TestSharedInformerFactory(t *testing.T) {
+	g := NewWithT(t)
+	ctx, cancel := context.WithCancel(t.Context())
+	client := fake.NewClientset(newRule("existing"))
+	factory := externalversions.NewSharedInformerFactory(client, 0)
+	lister := factory.Readiness().V1alpha1().NodeReadinessRules().Lister()
+
+	factory.Start(ctx.Done())
+	// Shutdown waits for the informers, which stop when ctx is cancelled.
+	defer factory.Shutdown()
+	defer cancel()
+	for typ, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		g.Expect(synced).To(BeTrue(), "informer for %v did not sync", typ)
+	}
+
+	got, err := lister.Get("existing")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.Name).To(Equal("existing"))
+
+	_, err = client.ReadinessV1alpha1().NodeReadinessRules().Create(ctx, newRule("created"), metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Eventually(func() ([]*v1alpha1.NodeReadinessRule, error) {
+		return lister.List(labels.Everything())
+	}).Should(HaveLen(2))
+}
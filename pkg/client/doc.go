@@ -0,0 +1,27 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client contains the generated client-go style clients for the
+// readiness.node.x-k8s.io API: a typed clientset in clientset/versioned,
+// shared informers in informers/externalversions, listers in listers and
+// server-side apply configurations in applyconfiguration.
+//
+// clientset/versioned/fake provides a clientset backed by an in-memory
+// object tracker for unit tests. Use NewClientset, which supports
+// server-side apply, rather than NewSimpleClientset.
+//
+// The packages are generated by hack/update-codegen.sh.
+package client